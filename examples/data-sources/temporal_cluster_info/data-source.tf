@@ -0,0 +1,6 @@
+# Read information about the connected cluster.
+data "temporal_cluster_info" "current" {}
+
+output "history_shard_count" {
+  value = data.temporal_cluster_info.current.history_shard_count
+}
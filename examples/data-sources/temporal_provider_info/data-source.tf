@@ -0,0 +1,6 @@
+# Record how the provider reaches the cluster, e.g. as compliance evidence.
+data "temporal_provider_info" "current" {}
+
+output "server_certificate_not_after" {
+  value = data.temporal_provider_info.current.server_certificate_not_after
+}
@@ -0,0 +1,12 @@
+# Check a query before it is used, so a typo fails the plan with a clear message.
+data "temporal_query_validate" "stuck_orders" {
+  namespace = "default"
+  query     = "WorkflowType = 'Orders' AND ExecutionStatus = 'Running' AND StartTime < '2024-01-01T00:00:00Z'"
+
+  lifecycle {
+    postcondition {
+      condition     = self.valid
+      error_message = "Invalid query: ${self.error}"
+    }
+  }
+}
@@ -0,0 +1,10 @@
+# Count workflow executions closed in the default namespace during each of the last 30 days.
+data "temporal_workflow_history_count" "default" {
+  namespace    = "default"
+  bucket_size  = "24h"
+  bucket_count = 30
+}
+
+output "closed_last_30_days" {
+  value = data.temporal_workflow_history_count.default.total
+}
@@ -0,0 +1,9 @@
+data "temporal_provider_info" "this" {}
+
+# The connection of the payments worker, with the same address and TLS settings as the provider.
+output "payments_worker_connection" {
+  value = provider::temporal::sdk_connection("go", data.temporal_provider_info.this.address, "payments", {
+    tls         = tostring(data.temporal_provider_info.this.tls)
+    api_key_env = "TEMPORAL_API_KEY"
+  })
+}
@@ -7,6 +7,5 @@ terraform {
 }
 
 provider "temporal" {
-  host = "127.0.0.1"
-  port = "7233"
+  address = "127.0.0.1:7233"
 }
@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    temporal = {
+      source = "platacard/temporal"
+    }
+  }
+}
+
+variable "temporal_api_key" {
+  type      = string
+  sensitive = true
+}
+
+# Authenticate with an API key, e.g. against Temporal Cloud.
+# The key can also be passed with the TEMPORAL_API_KEY environment variable.
+provider "temporal" {
+  address = "my-namespace.a1b2c.tmprl.cloud:7233"
+  api_key = var.temporal_api_key
+}
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    temporal = {
+      source = "platacard/temporal"
+    }
+  }
+}
+
+variable "temporal_api_key" {
+  type      = string
+  sensitive = true
+}
+
+# Connect to a Temporal Cloud namespace through the regional API key endpoint.
+# Requests carry the temporal-namespace header, and search attributes default
+# to the cloud namespace. Without address, the namespace endpoint
+# orders.a1b2c.tmprl.cloud:7233 is used, e.g. with a client certificate.
+provider "temporal" {
+  cloud_namespace = "orders.a1b2c"
+  address         = "us-east-1.aws.api.temporal.io:7233"
+  api_key         = var.temporal_api_key
+}
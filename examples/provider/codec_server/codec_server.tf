@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    temporal = {
+      source = "platacard/temporal"
+    }
+  }
+}
+
+# Encrypt the payloads the provider sends with the same codec server as the
+# workers and the Web UI, so workflows can decode them.
+provider "temporal" {
+  address = "temporal.example.com:7233"
+
+  codec_server {
+    endpoint = "https://codec.example.com"
+    auth     = "Bearer ${var.codec_token}"
+  }
+}
+
+variable "codec_token" {
+  type      = string
+  sensitive = true
+}
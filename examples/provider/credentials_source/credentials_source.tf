@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    temporal = {
+      source = "platacard/temporal"
+    }
+  }
+}
+
+# Read the API key from AWS Secrets Manager while the provider is configured, so it is never
+# passed through a Terraform variable. The secret holds either the key itself, or a JSON object
+# such as {"api_key": "..."} or {"tls_cert": "...", "tls_key": "..."}.
+provider "temporal" {
+  address = "my-namespace.a1b2c.tmprl.cloud:7233"
+
+  credentials_source {
+    aws_secret_arn = "arn:aws:secretsmanager:eu-west-1:123456789012:secret:temporal-api-key-AbCdEf"
+  }
+}
@@ -0,0 +1,39 @@
+terraform {
+  required_providers {
+    temporal = {
+      source = "platacard/temporal"
+    }
+  }
+}
+
+# Manage a primary and a standby cluster from one provider. Resources use the
+# provider's own connection unless they select a named endpoint with `cluster`.
+provider "temporal" {
+  address = "temporal-primary.example.com:7233"
+
+  tls {
+    cert = sensitive(file("path/to/primary-cert.pem"))
+    key  = sensitive(file("path/to/primary-key.pem"))
+  }
+
+  endpoints = {
+    standby = {
+      address = "temporal-standby.example.com:7233"
+      tls = {
+        cert = sensitive(file("path/to/standby-cert.pem"))
+        key  = sensitive(file("path/to/standby-key.pem"))
+      }
+    }
+  }
+}
+
+resource "temporal_namespace" "orders" {
+  name        = "orders"
+  owner_email = "admin@example.com"
+}
+
+resource "temporal_namespace" "orders_standby" {
+  cluster     = "standby"
+  name        = "orders"
+  owner_email = "admin@example.com"
+}
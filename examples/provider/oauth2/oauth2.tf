@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    temporal = {
+      source = "platacard/temporal"
+    }
+  }
+}
+
+variable "oauth2_client_secret" {
+  type      = string
+  sensitive = true
+}
+
+# Authenticate through an OIDC proxy with the client credentials flow.
+# Access tokens are refreshed automatically during long applies.
+provider "temporal" {
+  address = "temporal.example.com:443"
+
+  oauth2 {
+    token_url     = "https://idp.example.com/oauth2/token"
+    client_id     = "terraform"
+    client_secret = var.oauth2_client_secret
+    scopes        = ["temporal:admin"]
+  }
+}
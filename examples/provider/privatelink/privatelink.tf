@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    temporal = {
+      source = "platacard/temporal"
+    }
+  }
+}
+
+# Connect to a Temporal Cloud namespace through an AWS PrivateLink endpoint.
+# The provider dials the VPC endpoint, while the TLS server name stays the
+# canonical namespace hostname so the server certificate still verifies.
+provider "temporal" {
+  address = "vpce-0123456789abcdef0-abcdefgh.vpce-svc-0123456789abcdef0.us-east-1.vpce.amazonaws.com:7233"
+
+  tls {
+    cert        = sensitive(file("path/to/cert.pem"))
+    key         = sensitive(file("path/to/key.pem"))
+    server_name = "my-namespace.a1b2c.tmprl.cloud"
+  }
+}
@@ -1,4 +1,3 @@
 provider "temporal" {
-  host = "127.0.0.1"
-  port = "7233"
+  address = "127.0.0.1:7233"
 }
@@ -7,8 +7,7 @@ terraform {
 }
 
 provider "temporal" {
-  host = "127.0.0.1"
-  port = "7233"
+  address = "127.0.0.1:7233"
   
   # Add certs for mTLS auth.
   tls {
@@ -16,6 +15,16 @@ provider "temporal" {
     key  = sensitive(file("path/to/key.pem"))
     ca = sensitive(file("path/to/cacerts.pem"))
     server_name = "server-name"
+
+    # Alternatively, read the client certificate and key from files at apply time,
+    # e.g. when they are rotated by an agent on the host.
+    # cert_path = "/etc/temporal/tls/client.pem"
+    # key_path  = "/etc/temporal/tls/client.key"
+    # ca_path   = "/etc/temporal/tls/internal-ca.pem"
+
+    # Optionally restrict the TLS version and cipher suites.
+    min_version   = "1.2"
+    cipher_suites = ["TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"]
   }
 }
 
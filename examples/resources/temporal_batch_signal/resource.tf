@@ -0,0 +1,8 @@
+# Ask every running order workflow to reload its configuration. The plan shows the number of workflows in matching_count.
+resource "temporal_batch_signal" "reload_config" {
+  namespace   = "orders"
+  query       = "WorkflowType = 'OrderWorkflow' AND ExecutionStatus = 'Running'"
+  signal_name = "reload-config"
+  input       = [jsonencode({ version = 42 })]
+  wait        = true
+}
@@ -0,0 +1,2 @@
+# A search attribute of a Cloud namespace is imported by specifying 'namespace:search_attribute_name'
+terraform import temporal_cloud_namespace_search_attribute.customer_id orders.a2dd6:CustomerId
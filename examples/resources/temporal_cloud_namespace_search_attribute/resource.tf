@@ -0,0 +1,6 @@
+# Manage a search attribute of a Temporal Cloud namespace through the Cloud Ops API
+resource "temporal_cloud_namespace_search_attribute" "customer_id" {
+  namespace = "orders.a2dd6"
+  name      = "CustomerId"
+  type      = "Keyword"
+}
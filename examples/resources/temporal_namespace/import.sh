@@ -1,3 +1,6 @@
 # A Namespace can be imported by specifying the name.
 terraform import temporal_namespace.example example
 
+
+# A Namespace of a named provider endpoint is imported as 'name@cluster'.
+terraform import temporal_namespace.example_standby example@standby
@@ -5,3 +5,14 @@ resource "temporal_namespace" "example" {
   owner_email = "admin@example.com"
 }
 
+# Manage a namespace with a health check schedule, which starts the HealthCheck
+# workflow on the health-check task queue every minute.
+resource "temporal_namespace" "monitored" {
+  name        = "monitored"
+  owner_email = "admin@example.com"
+
+  health_check {
+    task_queue = "health-check"
+    interval   = "1m"
+  }
+}
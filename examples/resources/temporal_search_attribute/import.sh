@@ -3,4 +3,7 @@ terraform import temporal_search_attribute.example_search_attribute default:exam
 
 
 # A search attribute can also be imported by specifying just 'search_attibute_name' ('default' namespace will be used)
-terraform import temporal_search_attribute.example_search_attribute example
\ No newline at end of file
+terraform import temporal_search_attribute.example_search_attribute example
+
+# Attributes of a named provider endpoint have an @cluster suffix
+terraform import temporal_search_attribute.example_search_attribute default:example@standby
@@ -0,0 +1,5 @@
+# The default build ID of a task queue can be imported by specifying 'namespace:task_queue'
+terraform import temporal_task_queue_default_build_id.orders default:orders
+
+# Task queues of a named provider endpoint have an '@cluster' suffix
+terraform import temporal_task_queue_default_build_id.orders default:orders@standby
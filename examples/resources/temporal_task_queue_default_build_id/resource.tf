@@ -0,0 +1,7 @@
+# Make the build ID of the release being deployed the default of the task queue.
+# Requires experiments = ["worker_versioning"] in the provider configuration.
+resource "temporal_task_queue_default_build_id" "orders" {
+  namespace  = "default"
+  task_queue = "orders"
+  build_id   = "2024.06.01"
+}
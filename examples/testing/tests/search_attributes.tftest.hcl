@@ -0,0 +1,57 @@
+# Code generated by tools/examplegen; DO NOT EDIT.
+
+provider "temporal" {
+  address        = "127.0.0.1:7233"
+  allow_insecure = true
+}
+
+run "reads_CustomIntField" {
+  command = plan
+
+  module {
+    source = "./tests/search_attribute"
+  }
+
+  variables {
+    name = "CustomIntField"
+  }
+
+  assert {
+    condition     = data.temporal_search_attribute.this.type == "Int"
+    error_message = "CustomIntField is not seeded as Int."
+  }
+}
+
+run "reads_CustomKeywordField" {
+  command = plan
+
+  module {
+    source = "./tests/search_attribute"
+  }
+
+  variables {
+    name = "CustomKeywordField"
+  }
+
+  assert {
+    condition     = data.temporal_search_attribute.this.type == "Keyword"
+    error_message = "CustomKeywordField is not seeded as Keyword."
+  }
+}
+
+run "reads_CustomTextField" {
+  command = plan
+
+  module {
+    source = "./tests/search_attribute"
+  }
+
+  variables {
+    name = "CustomTextField"
+  }
+
+  assert {
+    condition     = data.temporal_search_attribute.this.type == "Text"
+    error_message = "CustomTextField is not seeded as Text."
+  }
+}
@@ -0,0 +1,130 @@
+// Package convert maps values between the Temporal API protos and the Terraform framework types.
+//
+// Every resource and data source converts through this package, so that a value is read back exactly as it was
+// written: a retention of 3 days stays 3 after a refresh, and a missing timestamp is null rather than the epoch.
+// Conversions from framework types treat null and unknown values as unset and return the zero value of the proto.
+package convert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/replication/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Day is the unit of namespace retention periods.
+const Day = 24 * time.Hour
+
+// Payload encodings, as set in the encoding metadata of payloads.
+const (
+	EncodingJSON   = "json/plain"
+	encodingHeader = "encoding"
+)
+
+// Days returns a duration in whole days, rounded down, or null for a nil duration.
+func Days(d *durationpb.Duration) types.Int64 {
+	if d == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(d.AsDuration() / Day))
+}
+
+// DaysValue returns a number of days as a duration, or nil when it is not set.
+func DaysValue(days types.Int64) *durationpb.Duration {
+	if days.IsNull() || days.IsUnknown() {
+		return nil
+	}
+	return durationpb.New(time.Duration(days.ValueInt64()) * Day)
+}
+
+// Duration returns a duration in Go syntax, e.g. "1h30m0s", or null for a nil duration.
+func Duration(d *durationpb.Duration) types.String {
+	if d == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(d.AsDuration().String())
+}
+
+// DurationValue parses a duration in Go syntax, e.g. "90m". It returns nil when the value is not set.
+func DurationValue(value types.String) (*durationpb.Duration, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	return durationpb.New(d), nil
+}
+
+// Timestamp returns a timestamp in RFC 3339 form in UTC, or null for a nil timestamp.
+func Timestamp(t *timestamppb.Timestamp) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.AsTime().UTC().Format(time.RFC3339Nano))
+}
+
+// Enum returns the shorthand name of an enum value, e.g. "Registered" for NAMESPACE_STATE_REGISTERED.
+func Enum[E fmt.Stringer](value E) types.String {
+	return types.StringValue(value.String())
+}
+
+// EnumValue parses the name of an enum value with the FromString function generated for the enum, which accepts
+// both the shorthand and the full name. It returns the zero value, UNSPECIFIED, when the value is not set.
+func EnumValue[E ~int32](value types.String, fromString func(string) (E, error)) (E, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return 0, nil
+	}
+	return fromString(value.ValueString())
+}
+
+// Payloads encodes JSON documents as json/plain payloads, the encoding of the Temporal SDKs' default data converter.
+func Payloads(values []string) (*common.Payloads, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	payloads := &common.Payloads{Payloads: make([]*common.Payload, 0, len(values))}
+	for i, value := range values {
+		if !json.Valid([]byte(value)) {
+			return nil, fmt.Errorf("value %d is not valid JSON", i)
+		}
+		payloads.Payloads = append(payloads.Payloads, &common.Payload{
+			Metadata: map[string][]byte{encodingHeader: []byte(EncodingJSON)},
+			Data:     []byte(value),
+		})
+	}
+	return payloads, nil
+}
+
+// ClusterNames returns the names of the clusters of a replication config, in order.
+func ClusterNames(clusters []*replication.ClusterReplicationConfig) []string {
+	names := make([]string, 0, len(clusters))
+	for _, cluster := range clusters {
+		names = append(names, cluster.GetClusterName())
+	}
+	return names
+}
+
+// JSON returns a message in protojson, with the camelCase field names of the proto JSON mapping. The output is
+// compacted, as protojson varies its whitespace between runs, so the value only changes when the message does.
+func JSON(m proto.Message) (types.String, error) {
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, b); err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringValue(compact.String()), nil
+}
@@ -0,0 +1,206 @@
+package convert
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/replication/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDays(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *durationpb.Duration
+		want types.Int64
+	}{
+		{"nil", nil, types.Int64Null()},
+		{"zero", durationpb.New(0), types.Int64Value(0)},
+		{"whole days", durationpb.New(3 * Day), types.Int64Value(3)},
+		{"rounded down", durationpb.New(3*Day + 23*time.Hour), types.Int64Value(3)},
+		{"less than a day", durationpb.New(time.Hour), types.Int64Value(0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Days(tt.in); !got.Equal(tt.want) {
+				t.Errorf("Days() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDaysValue(t *testing.T) {
+	tests := []struct {
+		name string
+		in   types.Int64
+		want *durationpb.Duration
+	}{
+		{"null", types.Int64Null(), nil},
+		{"unknown", types.Int64Unknown(), nil},
+		{"zero", types.Int64Value(0), durationpb.New(0)},
+		{"days", types.Int64Value(30), durationpb.New(30 * Day)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DaysValue(tt.in); !proto.Equal(got, tt.want) {
+				t.Errorf("DaysValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDaysRoundTrip(t *testing.T) {
+	for _, days := range []int64{0, 1, 3, 30, 365, 3650} {
+		if got := Days(DaysValue(types.Int64Value(days))); got.ValueInt64() != days {
+			t.Errorf("Days(DaysValue(%d)) = %s", days, got)
+		}
+	}
+}
+
+func TestDuration(t *testing.T) {
+	if got := Duration(nil); !got.IsNull() {
+		t.Errorf("Duration(nil) = %s, want null", got)
+	}
+	if got := Duration(durationpb.New(90 * time.Minute)); got.ValueString() != "1h30m0s" {
+		t.Errorf("Duration(90m) = %s, want 1h30m0s", got)
+	}
+}
+
+func TestDurationValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      types.String
+		want    *durationpb.Duration
+		wantErr bool
+	}{
+		{"null", types.StringNull(), nil, false},
+		{"unknown", types.StringUnknown(), nil, false},
+		{"minutes", types.StringValue("90m"), durationpb.New(90 * time.Minute), false},
+		{"compound", types.StringValue("1h30m"), durationpb.New(90 * time.Minute), false},
+		{"invalid", types.StringValue("1 day"), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DurationValue(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DurationValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("DurationValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimestamp(t *testing.T) {
+	if got := Timestamp(nil); !got.IsNull() {
+		t.Errorf("Timestamp(nil) = %s, want null", got)
+	}
+
+	local := time.Date(2024, 5, 1, 14, 30, 0, 500, time.FixedZone("CEST", 2*60*60))
+	got := Timestamp(timestamppb.New(local))
+	if got.ValueString() != "2024-05-01T12:30:00.0000005Z" {
+		t.Errorf("Timestamp() = %s, want the time in UTC", got)
+	}
+}
+
+func TestEnum(t *testing.T) {
+	if got := Enum(enums.NAMESPACE_STATE_REGISTERED); got.ValueString() != "Registered" {
+		t.Errorf("Enum(NAMESPACE_STATE_REGISTERED) = %s, want Registered", got)
+	}
+	if got := Enum(enums.ARCHIVAL_STATE_ENABLED); got.ValueString() != "Enabled" {
+		t.Errorf("Enum(ARCHIVAL_STATE_ENABLED) = %s, want Enabled", got)
+	}
+}
+
+func TestEnumValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      types.String
+		want    enums.ArchivalState
+		wantErr bool
+	}{
+		{"null", types.StringNull(), enums.ARCHIVAL_STATE_UNSPECIFIED, false},
+		{"unknown", types.StringUnknown(), enums.ARCHIVAL_STATE_UNSPECIFIED, false},
+		{"shorthand", types.StringValue("Enabled"), enums.ARCHIVAL_STATE_ENABLED, false},
+		{"full name", types.StringValue("ARCHIVAL_STATE_DISABLED"), enums.ARCHIVAL_STATE_DISABLED, false},
+		{"invalid", types.StringValue("On"), enums.ARCHIVAL_STATE_UNSPECIFIED, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EnumValue(tt.in, enums.ArchivalStateFromString)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EnumValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("EnumValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnumRoundTrip(t *testing.T) {
+	for value := range enums.NamespaceState_name {
+		state := enums.NamespaceState(value)
+		got, err := EnumValue(Enum(state), enums.NamespaceStateFromString)
+		if err != nil || got != state {
+			t.Errorf("EnumValue(Enum(%v)) = %v, %v", state, got, err)
+		}
+	}
+}
+
+func TestPayloads(t *testing.T) {
+	if got, err := Payloads(nil); got != nil || err != nil {
+		t.Errorf("Payloads(nil) = %v, %v, want nil", got, err)
+	}
+
+	got, err := Payloads([]string{`{"id":1}`, `"text"`})
+	if err != nil {
+		t.Fatalf("Payloads() error = %v", err)
+	}
+	want := &common.Payloads{Payloads: []*common.Payload{
+		{Metadata: map[string][]byte{"encoding": []byte("json/plain")}, Data: []byte(`{"id":1}`)},
+		{Metadata: map[string][]byte{"encoding": []byte("json/plain")}, Data: []byte(`"text"`)},
+	}}
+	if !proto.Equal(got, want) {
+		t.Errorf("Payloads() = %v, want %v", got, want)
+	}
+
+	if _, err := Payloads([]string{"not json"}); err == nil {
+		t.Error("Payloads(not json) did not fail")
+	}
+}
+
+func TestClusterNames(t *testing.T) {
+	if got := ClusterNames(nil); len(got) != 0 {
+		t.Errorf("ClusterNames(nil) = %v, want empty", got)
+	}
+
+	configs := []*replication.ClusterReplicationConfig{{ClusterName: "active"}, {ClusterName: "standby"}}
+	if got, want := ClusterNames(configs), []string{"active", "standby"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ClusterNames() = %v, want %v", got, want)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	ns := &workflowservice.DescribeNamespaceResponse{
+		NamespaceInfo: &namespace.NamespaceInfo{Name: "orders", State: enums.NAMESPACE_STATE_REGISTERED},
+		Config:        &namespace.NamespaceConfig{WorkflowExecutionRetentionTtl: durationpb.New(3 * Day)},
+	}
+	got, err := JSON(ns)
+	if err != nil {
+		t.Fatalf("JSON() error = %v", err)
+	}
+	want := `{"namespaceInfo":{"name":"orders","state":"NAMESPACE_STATE_REGISTERED"},"config":{"workflowExecutionRetentionTtl":"259200s"}}`
+	if got.ValueString() != want {
+		t.Errorf("JSON() = %s, want %s", got, want)
+	}
+}
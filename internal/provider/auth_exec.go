@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/oauth2"
+	grpcCreds "google.golang.org/grpc/credentials"
+)
+
+// authExecTimeout bounds each run of the auth_exec and credentials_helper commands.
+const authExecTimeout = time.Minute
+
+// authExecRefreshBefore is how long before its expiry a token is replaced, so it does not expire in flight.
+const authExecRefreshBefore = 30 * time.Second
+
+// authExecModel maps the auth_exec block of the provider configuration.
+type authExecModel struct {
+	Command types.String `tfsdk:"command"`
+	Args    types.List   `tfsdk:"args"`
+	Env     types.Map    `tfsdk:"env"`
+}
+
+var _ grpcCreds.PerRPCCredentials = &execCredentials{}
+
+// execCredentials sends the token printed by the auth_exec command with every RPC. The command is run again
+// once the token is about to expire.
+type execCredentials struct {
+	tokens oauth2.TokenSource
+}
+
+// execTokenSource runs the auth_exec command for each token.
+type execTokenSource struct {
+	command string
+	args    []string
+	env     []string
+}
+
+// newExecCredentials checks the auth_exec command can be found. It is first run by the first request.
+func newExecCredentials(ctx context.Context, m authExecModel) (*execCredentials, error) {
+	command, args, env, err := helperCommand(ctx, "auth_exec", m.Command, m.Args, m.Env)
+	if err != nil {
+		return nil, err
+	}
+	source := &execTokenSource{command: command, args: args, env: env}
+	return &execCredentials{tokens: oauth2.ReuseTokenSourceWithExpiry(nil, source, authExecRefreshBefore)}, nil
+}
+
+// helperCommand reads the command, arguments and environment of a block that runs a helper command, such as
+// auth_exec, and checks the command can be found. The environment is the one of the provider with env on top.
+func helperCommand(ctx context.Context, block string, command types.String, args types.List, env types.Map) (string, []string, []string, error) {
+	if command.ValueString() == "" {
+		return "", nil, nil, fmt.Errorf("the %s block requires command", block)
+	}
+	if _, err := exec.LookPath(command.ValueString()); err != nil {
+		return "", nil, nil, fmt.Errorf("unable to find the %s command: %w", block, err)
+	}
+
+	var commandArgs []string
+	if !args.IsNull() {
+		if diags := args.ElementsAs(ctx, &commandArgs, false); diags.HasError() {
+			return "", nil, nil, fmt.Errorf("unable to read args: %v", diags)
+		}
+	}
+	commandEnv := os.Environ()
+	if !env.IsNull() {
+		values := make(map[string]string)
+		if diags := env.ElementsAs(ctx, &values, false); diags.HasError() {
+			return "", nil, nil, fmt.Errorf("unable to read env: %v", diags)
+		}
+		// Sorted, so the environment of the command does not depend on map order.
+		names := make([]string, 0, len(values))
+		for name := range values {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			commandEnv = append(commandEnv, name+"="+values[name])
+		}
+	}
+	return command.ValueString(), commandArgs, commandEnv, nil
+}
+
+// runHelper runs a helper command and returns what it printed, with the messages it wrote to stderr in errors.
+func runHelper(ctx context.Context, command string, args, env []string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, authExecTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, command, args...)
+	cmd.Env = env
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("command %s failed: %w: %s", command, err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+func (c *execCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	token, err := c.tokens.Token()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"authorization": "Bearer " + token.AccessToken}, nil
+}
+
+// RequireTransportSecurity allows plaintext connections, like auth_token. The provider warns when a token is
+// sent that way.
+func (c *execCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// Token runs the command and parses its output. The command prints either the token alone, which is used for the
+// rest of the run, or an ExecCredential object as printed by Kubernetes credential plugins, whose
+// status.expirationTimestamp tells when to run the command again.
+func (s *execTokenSource) Token() (*oauth2.Token, error) {
+	output, err := runHelper(context.Background(), s.command, s.args, s.env)
+	if err != nil {
+		return nil, fmt.Errorf("auth_exec %w", err)
+	}
+	if !strings.HasPrefix(output, "{") {
+		if output == "" {
+			return nil, fmt.Errorf("auth_exec command %s printed no token", s.command)
+		}
+		return &oauth2.Token{AccessToken: output}, nil
+	}
+
+	var credential struct {
+		Status struct {
+			Token               string    `json:"token"`
+			ExpirationTimestamp time.Time `json:"expirationTimestamp"`
+		} `json:"status"`
+	}
+	if err := json.Unmarshal([]byte(output), &credential); err != nil {
+		return nil, fmt.Errorf("auth_exec command %s printed an invalid ExecCredential: %w", s.command, err)
+	}
+	if credential.Status.Token == "" {
+		return nil, fmt.Errorf("auth_exec command %s printed an ExecCredential without status.token", s.command)
+	}
+	return &oauth2.Token{AccessToken: credential.Status.Token, Expiry: credential.Status.ExpirationTimestamp}, nil
+}
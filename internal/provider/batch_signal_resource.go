@@ -0,0 +1,360 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"go.temporal.io/api/batch/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ganievs/terraform-provider-temporal/internal/convert"
+	"github.com/ganievs/terraform-provider-temporal/models"
+)
+
+var (
+	_ resource.Resource               = &BatchSignalResource{}
+	_ resource.ResourceWithConfigure  = &BatchSignalResource{}
+	_ resource.ResourceWithModifyPlan = &BatchSignalResource{}
+)
+
+// batchSignalPollInterval is how often a batch job is described while waiting for it to finish.
+const batchSignalPollInterval = time.Second
+
+// NewBatchSignalResource creates a new instance of BatchSignalResource.
+func NewBatchSignalResource() resource.Resource {
+	return &BatchSignalResource{}
+}
+
+// BatchSignalResource - a resource that signals every workflow matching a visibility query once, with a batch job.
+type BatchSignalResource struct {
+	client *TemporalClient
+}
+
+// Metadata sets the metadata for the batch signal resource, specifically the type name.
+func (r *BatchSignalResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_batch_signal"
+}
+
+// Schema returns the schema for the batch signal resource.
+func (r *BatchSignalResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Sends a signal to every workflow execution matching a visibility query, once, with a server-side batch job. " +
+			"The plan shows how many executions the query matches in `matching_count`, so the number of workflows signalled can be reviewed before the apply. " +
+			"Changing the namespace, query, signal or input sends the signal again with a new batch job. Destroying the resource does not undo the signals.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Job ID of the batch operation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace of the workflow executions",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Visibility query selecting the workflow executions to signal, e.g. `WorkflowType = 'Orders' AND ExecutionStatus = 'Running'`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"signal_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the signal to send",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"input": schema.ListAttribute{
+				MarkdownDescription: "Arguments of the signal, each a JSON document, e.g. `[jsonencode({ tier = \"gold\" })]`. They are sent as `json/plain` payloads, the encoding of the SDKs' default data converter, and encoded by the provider's `codec_server` if it has one.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"reason": schema.StringAttribute{
+				MarkdownDescription: "Reason recorded with the batch job. Defaults to `Signalled by Terraform`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Signalled by Terraform"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait": schema.BoolAttribute{
+				MarkdownDescription: "Wait for the batch job to finish, and fail the apply if it fails. Without it, the apply only starts the job.",
+				Optional:            true,
+			},
+			"matching_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of workflow executions the query matched when the signal was planned",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "State of the batch job: `Running`, `Completed` or `Failed`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"complete_operation_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of workflow executions signalled so far",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"failure_operation_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of workflow executions that could not be signalled",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster":     clusterAttribute(),
+			"rpc_timeout": rpcTimeoutAttribute(),
+		},
+	}
+}
+
+// Configure sets up the batch signal resource configuration.
+func (r *BatchSignalResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring Temporal Batch Signal Resource")
+
+	// Prevent panic if the provider has not been configured yet
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TemporalClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			summaryResourceConfType,
+			fmt.Sprintf("Expected *provider.TemporalClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+	tflog.Info(ctx, "Configured Temporal Batch Signal client", map[string]any{"success": true})
+}
+
+// ModifyPlan counts the workflow executions the query matches whenever the plan sends the signal, so the plan
+// shows how many workflows it affects.
+func (r *BatchSignalResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() || (!req.State.Raw.IsNull() && len(resp.RequiresReplace) == 0) {
+		return
+	}
+
+	var plan models.BatchSignalResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Namespace.IsUnknown() || plan.Query.IsUnknown() || plan.Cluster.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("matching_count"), types.Int64Unknown())...)
+		return
+	}
+
+	cluster, diags := r.client.cluster(plan.Cluster)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	count, err := cluster.workflowService.CountWorkflowExecutions(withRPCTimeout(ctx, plan.RPCTimeout), &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: plan.Namespace.ValueString(),
+		Query:     plan.Query.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("query"), summaryClientError,
+			fmt.Sprintf("Unable to count the workflow executions matching the query, got error: %s", requestErrorDetail(err)))
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("matching_count"), types.Int64Value(count.GetCount()))...)
+	// A new job starts with a new ID and state.
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("state"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("complete_operation_count"), types.Int64Unknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("failure_operation_count"), types.Int64Unknown())...)
+}
+
+// Create starts the batch job and, with wait, waits for it to finish.
+func (r *BatchSignalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.BatchSignalResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := r.client.timeOperation(ctx, "create", "temporal_batch_signal."+data.Namespace.ValueString()+":"+data.SignalName.ValueString())
+	defer done()
+	ctx = withRPCTimeout(ctx, data.RPCTimeout)
+
+	cluster, diags := r.client.cluster(data.Cluster)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := cluster.workflowService
+
+	var inputs []string
+	resp.Diagnostics.Append(data.Input.ElementsAs(ctx, &inputs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input, err := convert.Payloads(inputs)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("input"), summaryRequestError, "Invalid signal input: "+err.Error())
+		return
+	}
+	input, err = cluster.encodePayloads(ctx, data.Namespace.ValueString(), input)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("input"), summaryRequestError, "Unable to encode the signal input: "+err.Error())
+		return
+	}
+
+	jobID := uuid.NewString()
+	_, err = client.StartBatchOperation(ctx, &workflowservice.StartBatchOperationRequest{
+		Namespace:       data.Namespace.ValueString(),
+		VisibilityQuery: data.Query.ValueString(),
+		JobId:           jobID,
+		Reason:          data.Reason.ValueString(),
+		Operation: &workflowservice.StartBatchOperationRequest_SignalOperation{
+			SignalOperation: &batch.BatchOperationSignal{
+				Signal: data.SignalName.ValueString(),
+				Input:  input,
+			},
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(summaryRequestError, "Unable to start the batch signal: "+requestErrorDetail(err))
+		return
+	}
+	data.ID = types.StringValue(jobID)
+
+	job, err := r.describeJob(ctx, client, data.Namespace.ValueString(), jobID, data.Wait.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(summaryRequestError, fmt.Sprintf("Unable to read batch job %s: %s", jobID, requestErrorDetail(err)))
+		return
+	}
+	setBatchJob(&data, job)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if job.GetState() == enums.BATCH_OPERATION_STATE_FAILED {
+		resp.Diagnostics.AddError(summaryRequestError, fmt.Sprintf("Batch job %s failed after signalling %d workflow executions, %d failed.",
+			jobID, job.GetCompleteOperationCount(), job.GetFailureOperationCount()))
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Started batch job %s sending signal %s", jobID, data.SignalName.ValueString()))
+}
+
+// describeJob reads the batch job and, with wait, keeps reading it until it is no longer running.
+func (r *BatchSignalResource) describeJob(ctx context.Context, client workflowservice.WorkflowServiceClient, namespace, jobID string, wait bool) (*workflowservice.DescribeBatchOperationResponse, error) {
+	for {
+		job, err := client.DescribeBatchOperation(ctx, &workflowservice.DescribeBatchOperationRequest{
+			Namespace: namespace,
+			JobId:     jobID,
+		})
+		if err != nil || !wait || job.GetState() != enums.BATCH_OPERATION_STATE_RUNNING {
+			return job, err
+		}
+
+		select {
+		case <-time.After(batchSignalPollInterval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// setBatchJob copies the progress of the batch job to the model.
+func setBatchJob(data *models.BatchSignalResourceModel, job *workflowservice.DescribeBatchOperationResponse) {
+	data.State = convert.Enum(job.GetState())
+	data.CompleteOperationCount = types.Int64Value(job.GetCompleteOperationCount())
+	data.FailureOperationCount = types.Int64Value(job.GetFailureOperationCount())
+}
+
+// Read refreshes the progress of the batch job. The server forgets finished jobs after a while, in which case
+// the last known progress is kept.
+func (r *BatchSignalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.BatchSignalResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := r.client.timeOperation(ctx, "read", "temporal_batch_signal."+state.Namespace.ValueString()+":"+state.SignalName.ValueString())
+	defer done()
+	ctx = withRPCTimeout(ctx, state.RPCTimeout)
+
+	cluster, diags := r.client.cluster(state.Cluster)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := r.describeJob(ctx, cluster.workflowService, state.Namespace.ValueString(), state.ID.ValueString(), false)
+	if status.Code(err) == codes.NotFound {
+		tflog.Info(ctx, "Batch job no longer known to the server, keeping its last progress")
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(summaryClientError, fmt.Sprintf("Unable to read batch job %s, got error: %s", state.ID.ValueString(), requestErrorDetail(err)))
+		return
+	}
+	setBatchJob(&state, job)
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only stores the settings that do not send the signal again, wait and rpc_timeout.
+func (r *BatchSignalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.BatchSignalResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the batch signal from the state. Signals that were sent cannot be taken back.
+func (r *BatchSignalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing the batch signal from the state, the workflows it signalled are left as they are")
+}
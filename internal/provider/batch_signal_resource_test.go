@@ -0,0 +1,43 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBatchSignalResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "temporal_batch_signal" "test" {
+					namespace   = "default"
+					query       = "WorkflowType = 'TerraformAccNoSuchWorkflow'"
+					signal_name = "refresh"
+					input       = [jsonencode({ reason = "test" })]
+					wait        = true
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("temporal_batch_signal.test", "id"),
+					resource.TestCheckResourceAttr("temporal_batch_signal.test", "matching_count", "0"),
+					resource.TestCheckResourceAttr("temporal_batch_signal.test", "state", "Completed"),
+					resource.TestCheckResourceAttr("temporal_batch_signal.test", "reason", "Signalled by Terraform"),
+				),
+			},
+			// Changing wait does not send the signal again
+			{
+				Config: providerConfig + `
+				resource "temporal_batch_signal" "test" {
+					namespace   = "default"
+					query       = "WorkflowType = 'TerraformAccNoSuchWorkflow'"
+					signal_name = "refresh"
+					input       = [jsonencode({ reason = "test" })]
+				}`,
+				Check: resource.TestCheckResourceAttr("temporal_batch_signal.test", "state", "Completed"),
+			},
+		},
+	})
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// capability is a server feature that resources depend on, as reported by GetSystemInfo.
+type capability struct {
+	name       string
+	minVersion string
+	supported  func(*workflowservice.GetSystemInfoResponse_Capabilities) bool
+}
+
+var (
+	capabilitySchedules = capability{
+		name:       "schedules",
+		minVersion: "1.20",
+		supported:  (*workflowservice.GetSystemInfoResponse_Capabilities).GetSupportsSchedules,
+	}
+	capabilityBuildIDVersioning = capability{
+		name:       "build ID based versioning",
+		minVersion: "1.21",
+		supported:  (*workflowservice.GetSystemInfoResponse_Capabilities).GetBuildIdBasedVersioning,
+	}
+)
+
+// systemInfo holds the GetSystemInfo response of a frontend, so it is requested once per connection.
+type systemInfo struct {
+	mu       sync.Mutex
+	response *workflowservice.GetSystemInfoResponse
+}
+
+// systemInfo returns the version and capabilities of the frontend. Servers that do not implement GetSystemInfo
+// report no capabilities. Failed requests are not cached.
+func (c *TemporalClient) systemInfo(ctx context.Context) (*workflowservice.GetSystemInfoResponse, error) {
+	c.info.mu.Lock()
+	defer c.info.mu.Unlock()
+
+	if c.info.response != nil {
+		return c.info.response, nil
+	}
+
+	response, err := c.workflowService.GetSystemInfo(ctx, &workflowservice.GetSystemInfoRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		response, err = &workflowservice.GetSystemInfoResponse{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.info.response = response
+	return response, nil
+}
+
+// requireCapability fails with a diagnostic on attribute when the frontend does not support feature. When the
+// capabilities cannot be read, the check is skipped, and the requests that need the feature report the error.
+func (c *TemporalClient) requireCapability(ctx context.Context, feature capability, attribute path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	info, err := c.systemInfo(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to read the server capabilities", map[string]any{"err": err})
+		return diags
+	}
+	if feature.supported(info.GetCapabilities()) {
+		return diags
+	}
+
+	version := info.GetServerVersion()
+	if version == "" {
+		version = "unknown"
+	}
+	diags.AddAttributeError(attribute, summaryServerTooOld,
+		fmt.Sprintf("The Temporal server (version %s) does not support %s, which %s requires. Upgrade the server to %s or later, "+
+			"and check that %s are not disabled in its dynamic config.", version, feature.name, attribute, feature.minVersion, feature.name))
+	return diags
+}
+
+// planCapability checks feature against the cluster a resource is planned in. The check is left to apply while the
+// cluster is unknown.
+func (c *TemporalClient) planCapability(ctx context.Context, cluster types.String, feature capability, attribute path.Path) diag.Diagnostics {
+	if cluster.IsUnknown() {
+		return nil
+	}
+
+	client, diags := c.cluster(cluster)
+	if diags.HasError() {
+		return diags
+	}
+	return client.requireCapability(ctx, feature, attribute)
+}
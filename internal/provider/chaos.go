@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// chaosPageTokenPrefix marks the page tokens of pages held back by chaosInterceptor.
+const chaosPageTokenPrefix = "chaos-page-"
+
+// chaosConfig is the failure injection configured with the TEMPORAL_CHAOS environment variable, e.g.
+// "latency=200ms,unavailable=0.2,truncate_pages". It is meant for the acceptance tests and for checking that
+// pipelines cope with a slow or flaky frontend, never for real changes.
+type chaosConfig struct {
+	// latency is added before every request.
+	latency time.Duration
+	// unavailable is the probability that a request fails with Unavailable before it is sent.
+	unavailable float64
+	// truncatePages splits every page of a list response in two.
+	truncatePages bool
+}
+
+// envChaos parses the TEMPORAL_CHAOS environment variable. It returns nil when the variable is not set.
+func envChaos() (*chaosConfig, error) {
+	value := os.Getenv("TEMPORAL_CHAOS")
+	if value == "" {
+		return nil, nil
+	}
+
+	var config chaosConfig
+	for _, setting := range strings.Split(value, ",") {
+		name, arg, _ := strings.Cut(strings.TrimSpace(setting), "=")
+		var err error
+		switch name {
+		case "latency":
+			config.latency, err = time.ParseDuration(arg)
+		case "unavailable":
+			config.unavailable, err = strconv.ParseFloat(arg, 64)
+			if err == nil && (config.unavailable < 0 || config.unavailable > 1) {
+				err = fmt.Errorf("must be a probability between 0 and 1")
+			}
+		case "truncate_pages":
+			config.truncatePages = true
+		default:
+			err = fmt.Errorf("unknown setting, expected latency, unavailable or truncate_pages")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid setting %q: %w", setting, err)
+		}
+	}
+	return &config, nil
+}
+
+// chaosInterceptor injects the configured failures. Unavailable errors are only injected into requests the
+// provider retries, so that the retries are exercised rather than operations failing at random. Truncated pages
+// hold back the second half of each page and serve it for the next page token, without asking the server.
+func chaosInterceptor(config *chaosConfig) grpc.UnaryClientInterceptor {
+	var mu sync.Mutex
+	pending := make(map[string]proto.Message)
+	var pages atomic.Int64
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if config.latency > 0 {
+			select {
+			case <-time.After(config.latency):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if config.unavailable > 0 && retryable(method, req, codes.Unavailable) && rand.Float64() < config.unavailable {
+			tflog.Debug(ctx, "Injected Unavailable error", map[string]any{"method": method})
+			return status.Error(codes.Unavailable, "injected by TEMPORAL_CHAOS")
+		}
+
+		request, ok := req.(proto.Message)
+		response, ok2 := reply.(proto.Message)
+		if !config.truncatePages || !ok || !ok2 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		if token := pageToken(request.ProtoReflect()); strings.HasPrefix(token, chaosPageTokenPrefix) {
+			mu.Lock()
+			rest, ok := pending[token]
+			delete(pending, token)
+			mu.Unlock()
+			if !ok {
+				return status.Error(codes.InvalidArgument, "unknown page token injected by TEMPORAL_CHAOS")
+			}
+			proto.Merge(response, rest)
+			return nil
+		}
+
+		if err := invoker(ctx, method, req, reply, cc, opts...); err != nil {
+			return err
+		}
+
+		token := fmt.Sprintf("%s%d", chaosPageTokenPrefix, pages.Add(1))
+		if rest, ok := truncatePage(response.ProtoReflect(), token); ok {
+			tflog.Debug(ctx, "Injected truncated page", map[string]any{"method": method})
+			mu.Lock()
+			pending[token] = rest
+			mu.Unlock()
+		}
+		return nil
+	}
+}
+
+// pageToken returns the next_page_token of a list request, if it has one.
+func pageToken(m protoreflect.Message) string {
+	field := m.Descriptor().Fields().ByName("next_page_token")
+	if field == nil || field.Kind() != protoreflect.BytesKind {
+		return ""
+	}
+	return string(m.Get(field).Bytes())
+}
+
+// truncatePage keeps the first half of the items of a list response and returns a copy of the response holding
+// the second half and the original next page token. The response then points to the copy with token.
+// Responses without a next_page_token, or without a single repeated message field, are left alone.
+func truncatePage(m protoreflect.Message, token string) (proto.Message, bool) {
+	tokenField := m.Descriptor().Fields().ByName("next_page_token")
+	if tokenField == nil || tokenField.Kind() != protoreflect.BytesKind {
+		return nil, false
+	}
+
+	var items protoreflect.FieldDescriptor
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if field := fields.Get(i); field.IsList() && field.Kind() == protoreflect.MessageKind {
+			if items != nil {
+				return nil, false
+			}
+			items = field
+		}
+	}
+	if items == nil || m.Get(items).List().Len() < 2 {
+		return nil, false
+	}
+
+	list := m.Get(items).List()
+	keep := list.Len() / 2
+
+	rest := proto.Clone(m.Interface()).ProtoReflect()
+	cloned := rest.Get(items).List()
+	restItems := rest.NewField(items).List()
+	for i := keep; i < cloned.Len(); i++ {
+		restItems.Append(cloned.Get(i))
+	}
+	rest.Set(items, protoreflect.ValueOfList(restItems))
+
+	list.Truncate(keep)
+	m.Set(tokenField, protoreflect.ValueOfBytes([]byte(token)))
+	return rest.Interface(), true
+}
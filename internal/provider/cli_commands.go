@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"github.com/ganievs/terraform-provider-temporal/internal/convert"
+)
+
+// cliCommand renders the temporal CLI command equivalent to a mutating request.
+// It returns false for requests that do not change anything or have no CLI counterpart.
+// Namespace data values are redacted because they may hold secrets.
+func cliCommand(req interface{}) (string, bool) {
+	var args []string
+
+	switch r := req.(type) {
+	case *workflowservice.RegisterNamespaceRequest:
+		args = []string{"operator", "namespace", "create", "--namespace", r.GetNamespace()}
+		args = appendFlag(args, "--description", r.GetDescription())
+		args = appendFlag(args, "--email", r.GetOwnerEmail())
+		args = appendFlag(args, "--retention", convert.Duration(r.GetWorkflowExecutionRetentionPeriod()).ValueString())
+		args = appendFlag(args, "--active-cluster", r.GetActiveClusterName())
+		for _, name := range convert.ClusterNames(r.GetClusters()) {
+			args = appendFlag(args, "--cluster", name)
+		}
+		if r.GetIsGlobalNamespace() {
+			args = append(args, "--global", "true")
+		}
+		args = appendArchivalFlags(args, r.GetHistoryArchivalState(), r.GetHistoryArchivalUri(), r.GetVisibilityArchivalState(), r.GetVisibilityArchivalUri())
+		args = appendDataFlags(args, r.GetData())
+	case *workflowservice.UpdateNamespaceRequest:
+		args = []string{"operator", "namespace", "update", "--namespace", r.GetNamespace()}
+		args = appendFlag(args, "--description", r.GetUpdateInfo().GetDescription())
+		args = appendFlag(args, "--email", r.GetUpdateInfo().GetOwnerEmail())
+		args = appendFlag(args, "--retention", convert.Duration(r.GetConfig().GetWorkflowExecutionRetentionTtl()).ValueString())
+		args = appendFlag(args, "--active-cluster", r.GetReplicationConfig().GetActiveClusterName())
+		for _, name := range convert.ClusterNames(r.GetReplicationConfig().GetClusters()) {
+			args = appendFlag(args, "--cluster", name)
+		}
+		if r.GetPromoteNamespace() {
+			args = append(args, "--promote-global")
+		}
+		args = appendArchivalFlags(args, r.GetConfig().GetHistoryArchivalState(), r.GetConfig().GetHistoryArchivalUri(), r.GetConfig().GetVisibilityArchivalState(), r.GetConfig().GetVisibilityArchivalUri())
+		args = appendDataFlags(args, r.GetUpdateInfo().GetData())
+	case *operatorservice.DeleteNamespaceRequest:
+		args = []string{"operator", "namespace", "delete", "--namespace", r.GetNamespace(), "--yes"}
+	case *operatorservice.AddSearchAttributesRequest:
+		args = []string{"operator", "search-attribute", "create", "--namespace", r.GetNamespace()}
+		names := make([]string, 0, len(r.GetSearchAttributes()))
+		for name := range r.GetSearchAttributes() {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			args = append(args, "--name", name, "--type", r.GetSearchAttributes()[name].String())
+		}
+	case *operatorservice.RemoveSearchAttributesRequest:
+		args = []string{"operator", "search-attribute", "remove", "--namespace", r.GetNamespace()}
+		for _, name := range r.GetSearchAttributes() {
+			args = append(args, "--name", name)
+		}
+		args = append(args, "--yes")
+	default:
+		return "", false
+	}
+
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = shellQuote(arg)
+	}
+
+	return "temporal " + strings.Join(quoted, " "), true
+}
+
+func appendFlag(args []string, flag, value string) []string {
+	if value == "" {
+		return args
+	}
+	return append(args, flag, value)
+}
+
+func appendArchivalFlags(args []string, historyState enums.ArchivalState, historyURI string, visibilityState enums.ArchivalState, visibilityURI string) []string {
+	if historyState != enums.ARCHIVAL_STATE_UNSPECIFIED {
+		args = append(args, "--history-archival-state", strings.ToLower(historyState.String()))
+	}
+	args = appendFlag(args, "--history-uri", historyURI)
+	if visibilityState != enums.ARCHIVAL_STATE_UNSPECIFIED {
+		args = append(args, "--visibility-archival-state", strings.ToLower(visibilityState.String()))
+	}
+	return appendFlag(args, "--visibility-uri", visibilityURI)
+}
+
+func appendDataFlags(args []string, data map[string]string) []string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		args = append(args, "--data", key+"="+redacted)
+	}
+	return args
+}
+
+// shellQuote quotes s for a POSIX shell when it contains anything but safe characters.
+func shellQuote(s string) string {
+	if s != "" && strings.IndexFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_./:=@,+", r))
+	}) < 0 {
+		return s
+	}
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(s, "'", `'\''`))
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel/trace"
+	"go.temporal.io/api/cloud/cloudservice/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	"github.com/ganievs/terraform-provider-temporal/temporalclient"
+)
+
+// searchAttributeBatchWindow is how long the first search attribute added to a namespace waits for others
+// to join its AddSearchAttributes call.
+const searchAttributeBatchWindow = 250 * time.Millisecond
+
+// TemporalClient is the provider data handed to resources and data sources.
+// It carries the typed service clients and the state shared by all of them within one provider instance.
+type TemporalClient struct {
+	workflowService  workflowservice.WorkflowServiceClient
+	operatorService  operatorservice.OperatorServiceClient
+	searchAttributes *searchAttributeBatcher
+	info             *systemInfo
+	deletes          *deleteGuard
+	timings          bool
+	devMode          bool
+	tracer           trace.Tracer
+	codec            *codecServer
+	experiments      map[string]bool
+	namespace        string
+	workspace        string
+	connection       connectionInfo
+	cloudService     cloudservice.CloudServiceClient
+	cloudNamespaces  *cloudNamespaceLocks
+
+	// endpoints holds the clients of the named endpoints, selected by the cluster attribute of resources.
+	endpoints map[string]*TemporalClient
+}
+
+// newTemporalClient wraps a connection to the Temporal frontend.
+func newTemporalClient(conn grpc.ClientConnInterface) *TemporalClient {
+	services := temporalclient.New(conn)
+
+	return &TemporalClient{
+		workflowService:  services.WorkflowService,
+		operatorService:  services.OperatorService,
+		searchAttributes: newSearchAttributeBatcher(services.OperatorService, searchAttributeBatchWindow),
+		info:             &systemInfo{},
+		cloudNamespaces:  &cloudNamespaceLocks{},
+	}
+}
+
+// defaultNamespace returns the namespace of search attributes that do not set one.
+func (c *TemporalClient) defaultNamespace() string {
+	if c == nil || c.namespace == "" {
+		return "default"
+	}
+	return c.namespace
+}
+
+// deleteGuard counts the objects a plan deletes, including replacements, and rejects the plan once
+// there are more than max_delete_operations of them. A nil guard allows any number of deletions.
+type deleteGuard struct {
+	max int64
+
+	mu      sync.Mutex
+	planned map[string]struct{}
+}
+
+func newDeleteGuard(max int64) *deleteGuard {
+	return &deleteGuard{
+		max:     max,
+		planned: make(map[string]struct{}),
+	}
+}
+
+// plan records that the object with the given address is deleted. Planning the same object again does not count.
+func (g *deleteGuard) plan(address string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if g == nil {
+		return diags
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.planned[address] = struct{}{}
+	if int64(len(g.planned)) > g.max {
+		diags.AddError(summaryTooManyDeletions,
+			fmt.Sprintf("Deleting %s would exceed max_delete_operations: the plan deletes or replaces more than %d Temporal objects. "+
+				"Review the plan, and raise max_delete_operations if the deletions are intended.", address, g.max))
+	}
+	return diags
+}
+
+// searchAttributeBatcher coalesces search attributes added to the same namespace at about the same time into
+// a single AddSearchAttributes call. On Elasticsearch backed clusters every call updates the index mapping,
+// so creating many temporal_search_attribute resources one call at a time is slow.
+type searchAttributeBatcher struct {
+	client operatorservice.OperatorServiceClient
+	window time.Duration
+
+	mu      sync.Mutex
+	pending map[string]*searchAttributeBatch
+}
+
+// searchAttributeBatch is a pending AddSearchAttributes call for one namespace.
+type searchAttributeBatch struct {
+	attributes map[string]enums.IndexedValueType
+	errs       map[string]error
+	done       chan struct{}
+}
+
+func newSearchAttributeBatcher(client operatorservice.OperatorServiceClient, window time.Duration) *searchAttributeBatcher {
+	return &searchAttributeBatcher{
+		client:  client,
+		window:  window,
+		pending: make(map[string]*searchAttributeBatch),
+	}
+}
+
+// Add queues a search attribute and waits until the batch it joined has been sent.
+func (b *searchAttributeBatcher) Add(ctx context.Context, namespace, name string, valueType enums.IndexedValueType) error {
+	b.mu.Lock()
+	batch, ok := b.pending[namespace]
+	if !ok {
+		batch = &searchAttributeBatch{
+			attributes: make(map[string]enums.IndexedValueType),
+			done:       make(chan struct{}),
+		}
+		b.pending[namespace] = batch
+
+		// The batch is sent for every resource that joins it, so none of the values of the request that opened it,
+		// such as its rpc_timeout, cancellation, timings or log fields, apply to it.
+		time.AfterFunc(b.window, func() { b.flush(context.Background(), namespace, batch) })
+	}
+	batch.attributes[name] = valueType
+	b.mu.Unlock()
+
+	select {
+	case <-batch.done:
+		tflog.Debug(ctx, "Added search attributes", map[string]any{"namespace": namespace, "count": len(batch.attributes)})
+		return batch.errs[name]
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// flush sends the batch. If a batch of several attributes is rejected, they are retried one by one so that
+// each resource gets its own error.
+func (b *searchAttributeBatcher) flush(ctx context.Context, namespace string, batch *searchAttributeBatch) {
+	b.mu.Lock()
+	delete(b.pending, namespace)
+	b.mu.Unlock()
+
+	defer close(batch.done)
+
+	_, err := b.client.AddSearchAttributes(ctx, &operatorservice.AddSearchAttributesRequest{
+		Namespace:        namespace,
+		SearchAttributes: batch.attributes,
+	})
+	if err == nil {
+		return
+	}
+
+	batch.errs = make(map[string]error, len(batch.attributes))
+	if len(batch.attributes) == 1 {
+		for name := range batch.attributes {
+			batch.errs[name] = err
+		}
+		return
+	}
+
+	for name, valueType := range batch.attributes {
+		_, batch.errs[name] = b.client.AddSearchAttributes(ctx, &operatorservice.AddSearchAttributesRequest{
+			Namespace:        namespace,
+			SearchAttributes: map[string]enums.IndexedValueType{name: valueType},
+		})
+	}
+}
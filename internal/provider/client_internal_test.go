@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	"google.golang.org/grpc"
+)
+
+// fakeSearchAttributeClient records the AddSearchAttributes calls it gets, and rejects those adding an attribute
+// whose name starts with "bad". A non-nil block holds each call until it is closed.
+type fakeSearchAttributeClient struct {
+	operatorservice.OperatorServiceClient
+	block chan struct{}
+
+	mu    sync.Mutex
+	calls [][]string
+	ctxs  []context.Context
+}
+
+func (c *fakeSearchAttributeClient) AddSearchAttributes(ctx context.Context, req *operatorservice.AddSearchAttributesRequest, _ ...grpc.CallOption) (*operatorservice.AddSearchAttributesResponse, error) {
+	names := make([]string, 0, len(req.GetSearchAttributes()))
+	for name := range req.GetSearchAttributes() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	c.mu.Lock()
+	c.calls = append(c.calls, names)
+	c.ctxs = append(c.ctxs, ctx)
+	c.mu.Unlock()
+
+	if c.block != nil {
+		<-c.block
+	}
+	for _, name := range names {
+		if strings.HasPrefix(name, "bad") {
+			return nil, errors.New("invalid search attribute " + name)
+		}
+	}
+	return &operatorservice.AddSearchAttributesResponse{}, nil
+}
+
+func (c *fakeSearchAttributeClient) sent() [][]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([][]string(nil), c.calls...)
+}
+
+// addAll adds the named attributes concurrently and returns their errors by name.
+func addAll(ctx context.Context, b *searchAttributeBatcher, names ...string) map[string]error {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	errs := make(map[string]error, len(names))
+	for _, name := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := b.Add(ctx, "orders", name, enums.INDEXED_VALUE_TYPE_KEYWORD)
+			mu.Lock()
+			errs[name] = err
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return errs
+}
+
+func TestSearchAttributeBatcher(t *testing.T) {
+	t.Run("attributes added together are sent in one call", func(t *testing.T) {
+		client := &fakeSearchAttributeClient{}
+		errs := addAll(context.Background(), newSearchAttributeBatcher(client, 50*time.Millisecond), "a", "b", "c")
+
+		for name, err := range errs {
+			if err != nil {
+				t.Errorf("Add(%s) error = %v", name, err)
+			}
+		}
+		if calls := client.sent(); len(calls) != 1 || strings.Join(calls[0], ",") != "a,b,c" {
+			t.Errorf("calls = %v, want one call with a, b and c", calls)
+		}
+	})
+
+	t.Run("a rejected batch is retried one by one", func(t *testing.T) {
+		client := &fakeSearchAttributeClient{}
+		errs := addAll(context.Background(), newSearchAttributeBatcher(client, 50*time.Millisecond), "a", "bad", "c")
+
+		if errs["a"] != nil || errs["c"] != nil {
+			t.Errorf("Add() errors = %v, want only bad to fail", errs)
+		}
+		if errs["bad"] == nil || !strings.Contains(errs["bad"].Error(), "bad") {
+			t.Errorf("Add(bad) error = %v, want its own error", errs["bad"])
+		}
+		calls := client.sent()
+		if len(calls) != 4 || strings.Join(calls[0], ",") != "a,bad,c" {
+			t.Fatalf("calls = %v, want the batch and then one call per attribute", calls)
+		}
+		for _, call := range calls[1:] {
+			if len(call) != 1 {
+				t.Errorf("retry call = %v, want a single attribute", call)
+			}
+		}
+	})
+
+	t.Run("a rejected single attribute is not retried", func(t *testing.T) {
+		client := &fakeSearchAttributeClient{}
+		errs := addAll(context.Background(), newSearchAttributeBatcher(client, time.Millisecond), "bad")
+
+		if errs["bad"] == nil {
+			t.Error("Add(bad) did not fail")
+		}
+		if calls := client.sent(); len(calls) != 1 {
+			t.Errorf("calls = %v, want one", calls)
+		}
+	})
+
+	t.Run("an attribute added while a batch is sent opens a new one", func(t *testing.T) {
+		client := &fakeSearchAttributeClient{block: make(chan struct{})}
+		b := newSearchAttributeBatcher(client, time.Millisecond)
+
+		first := make(chan error)
+		go func() { first <- b.Add(context.Background(), "orders", "a", enums.INDEXED_VALUE_TYPE_KEYWORD) }()
+		for len(client.sent()) == 0 {
+			time.Sleep(time.Millisecond)
+		}
+
+		second := make(chan error)
+		go func() { second <- b.Add(context.Background(), "orders", "b", enums.INDEXED_VALUE_TYPE_KEYWORD) }()
+		for len(client.sent()) < 2 {
+			time.Sleep(time.Millisecond)
+		}
+		close(client.block)
+
+		if err := <-first; err != nil {
+			t.Errorf("Add(a) error = %v", err)
+		}
+		if err := <-second; err != nil {
+			t.Errorf("Add(b) error = %v", err)
+		}
+		if calls := client.sent(); len(calls) != 2 || strings.Join(calls[0], ",") != "a" || strings.Join(calls[1], ",") != "b" {
+			t.Errorf("calls = %v, want a and b in separate calls", calls)
+		}
+	})
+
+	t.Run("the batch does not inherit the request that opened it", func(t *testing.T) {
+		client := &fakeSearchAttributeClient{}
+		b := newSearchAttributeBatcher(client, 20*time.Millisecond)
+
+		ctx, cancel := context.WithCancel(withRPCTimeout(context.Background(), types.StringValue("1s")))
+		ctx = context.WithValue(ctx, operationTimingsKey{}, &operationTimings{byMethod: map[string]time.Duration{}})
+		cancel()
+		if err := b.Add(ctx, "orders", "a", enums.INDEXED_VALUE_TYPE_KEYWORD); !errors.Is(err, context.Canceled) {
+			t.Fatalf("Add() error = %v, want context.Canceled", err)
+		}
+		for len(client.sent()) == 0 {
+			time.Sleep(time.Millisecond)
+		}
+
+		client.mu.Lock()
+		flushCtx := client.ctxs[0]
+		client.mu.Unlock()
+		if flushCtx.Err() != nil {
+			t.Errorf("flush context error = %v, want none", flushCtx.Err())
+		}
+		if flushCtx.Value(rpcTimeoutKey{}) != nil || flushCtx.Value(operationTimingsKey{}) != nil {
+			t.Error("flush context carries the rpc_timeout or timings of the request that opened the batch")
+		}
+	})
+}
+
+func TestDeleteGuardPlan(t *testing.T) {
+	tests := []struct {
+		name      string
+		max       int64
+		addresses []string
+		wantErrAt int // index of the first rejected address, -1 when all are allowed
+	}{
+		{name: "exactly max", max: 2, addresses: []string{"temporal_namespace.a", "temporal_namespace.b"}, wantErrAt: -1},
+		{name: "max plus one", max: 2, addresses: []string{"temporal_namespace.a", "temporal_namespace.b", "temporal_namespace.c"}, wantErrAt: 2},
+		{name: "same address counts once", max: 1, addresses: []string{"temporal_namespace.a", "temporal_namespace.a", "temporal_namespace.a"}, wantErrAt: -1},
+		{name: "zero rejects any deletion", max: 0, addresses: []string{"temporal_namespace.a"}, wantErrAt: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newDeleteGuard(tt.max)
+			for i, address := range tt.addresses {
+				diags := g.plan(address)
+				if wantErr := tt.wantErrAt >= 0 && i >= tt.wantErrAt; diags.HasError() != wantErr {
+					t.Fatalf("plan(%s) #%d diagnostics = %v, want error %t", address, i, diags, wantErr)
+				}
+				if diags.HasError() && (diags[0].Summary() != summaryTooManyDeletions || !strings.Contains(diags[0].Detail(), address)) {
+					t.Errorf("plan(%s) diagnostic = %s: %s", address, diags[0].Summary(), diags[0].Detail())
+				}
+			}
+		})
+	}
+
+	t.Run("nil guard", func(t *testing.T) {
+		var g *deleteGuard
+		if diags := g.plan("temporal_namespace.a"); diags.HasError() {
+			t.Errorf("plan() on a nil guard = %v, want any deletion allowed", diags)
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"crypto/tls"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"go.temporal.io/api/cloud/cloudservice/v1"
+	"google.golang.org/grpc"
+	grpcCreds "google.golang.org/grpc/credentials"
+)
+
+// defaultCloudAPIAddress is the address of the Temporal Cloud Ops API.
+const defaultCloudAPIAddress = "saas-api.tmprl.cloud:443"
+
+// cloudAPIVersion is the version of the Cloud Ops API the provider is written against. It is sent with every
+// request, as the API changes the meaning of fields between versions.
+const cloudAPIVersion = "2024-10-01-00"
+
+// cloudOperationPollInterval is how often an asynchronous operation is checked when the API does not suggest
+// an interval.
+const cloudOperationPollInterval = time.Second
+
+// newCloudClient creates a client of the Cloud Ops API authenticated with an API key. opts are added to the
+// connection, e.g. the interceptors of the frontend connection.
+func newCloudClient(address, apiKey string, opts ...grpc.DialOption) (cloudservice.CloudServiceClient, error) {
+	conn, err := grpc.NewClient(address, append(opts[:len(opts):len(opts)],
+		grpc.WithTransportCredentials(grpcCreds.NewTLS(&tls.Config{})),
+		grpc.WithPerRPCCredentials(apiKeyCredentials(apiKey)),
+		grpc.WithChainUnaryInterceptor(headersInterceptor(map[string]string{"temporal-cloud-api-version": cloudAPIVersion})),
+	)...)
+	if err != nil {
+		return nil, err
+	}
+	return cloudservice.NewCloudServiceClient(conn), nil
+}
+
+// cloudNamespaceLocks serializes the changes the provider makes to each Cloud namespace. Every change sends the
+// resource version it was based on, so changes made in parallel, such as adding several search attributes, would
+// otherwise fail each other.
+type cloudNamespaceLocks struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// lock locks the namespace and returns the function that unlocks it.
+func (l *cloudNamespaceLocks) lock(namespace string) func() {
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = make(map[string]*sync.Mutex)
+	}
+	lock, ok := l.locks[namespace]
+	if !ok {
+		lock = &sync.Mutex{}
+		l.locks[namespace] = lock
+	}
+	l.mu.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+// cloud returns the client of the Cloud Ops API, which is only created when the provider has an API key for it.
+func (c *TemporalClient) cloud() (cloudservice.CloudServiceClient, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if c.cloudService == nil {
+		diags.AddError(summaryCloudAPINotConfigured,
+			"Temporal Cloud resources are managed through the Cloud Ops API, which requires an API key. Set cloud_api_key or api_key in the provider configuration.")
+	}
+	return c.cloudService, diags
+}
@@ -0,0 +1,318 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"go.temporal.io/api/cloud/cloudservice/v1"
+	cloudnamespace "go.temporal.io/api/cloud/namespace/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ganievs/terraform-provider-temporal/temporalclient"
+
+	"github.com/ganievs/terraform-provider-temporal/models"
+)
+
+var (
+	_ resource.Resource                = &CloudNamespaceSearchAttributeResource{}
+	_ resource.ResourceWithConfigure   = &CloudNamespaceSearchAttributeResource{}
+	_ resource.ResourceWithImportState = &CloudNamespaceSearchAttributeResource{}
+)
+
+// cloudSearchAttributeTypes maps the type names of temporal_search_attribute to the types of the Cloud Ops API.
+var cloudSearchAttributeTypes = map[string]cloudnamespace.NamespaceSpec_SearchAttributeType{
+	"Text":        cloudnamespace.NamespaceSpec_SEARCH_ATTRIBUTE_TYPE_TEXT,
+	"Keyword":     cloudnamespace.NamespaceSpec_SEARCH_ATTRIBUTE_TYPE_KEYWORD,
+	"Int":         cloudnamespace.NamespaceSpec_SEARCH_ATTRIBUTE_TYPE_INT,
+	"Double":      cloudnamespace.NamespaceSpec_SEARCH_ATTRIBUTE_TYPE_DOUBLE,
+	"Bool":        cloudnamespace.NamespaceSpec_SEARCH_ATTRIBUTE_TYPE_BOOL,
+	"Datetime":    cloudnamespace.NamespaceSpec_SEARCH_ATTRIBUTE_TYPE_DATETIME,
+	"KeywordList": cloudnamespace.NamespaceSpec_SEARCH_ATTRIBUTE_TYPE_KEYWORD_LIST,
+}
+
+// cloudSearchAttributeTypeName returns the type name of a Cloud search attribute type.
+func cloudSearchAttributeTypeName(attributeType cloudnamespace.NamespaceSpec_SearchAttributeType) types.String {
+	for name, value := range cloudSearchAttributeTypes {
+		if value == attributeType {
+			return types.StringValue(name)
+		}
+	}
+	return types.StringValue("Unspecified")
+}
+
+// NewCloudNamespaceSearchAttributeResource creates a new instance of CloudNamespaceSearchAttributeResource.
+func NewCloudNamespaceSearchAttributeResource() resource.Resource {
+	return &CloudNamespaceSearchAttributeResource{}
+}
+
+// CloudNamespaceSearchAttributeResource - a search attribute of a Temporal Cloud namespace, managed through the
+// Cloud Ops API rather than the OperatorService of the namespace endpoint.
+type CloudNamespaceSearchAttributeResource struct {
+	client *TemporalClient
+}
+
+// Metadata sets the metadata for the Cloud namespace search attribute resource, specifically the type name.
+func (r *CloudNamespaceSearchAttributeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_namespace_search_attribute"
+}
+
+// Schema returns the schema for the Cloud namespace search attribute resource.
+func (r *CloudNamespaceSearchAttributeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Custom search attribute of a Temporal Cloud namespace, managed through the Cloud Ops API with the provider's `cloud_api_key`. " +
+			"Temporal Cloud does not delete search attributes: destroying the resource only removes it from the state, and the attribute can be imported again.",
+
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "ID of the Cloud namespace, e.g. `orders.a2dd6`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Search Attribute Name. Changing it renames the attribute, which keeps the values of existing workflows.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Search Attribute Indexed Value Type, which defines the type of data stored in the attribute",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Text", "Keyword", "Int", "Double", "Bool", "Datetime", "KeywordList"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rpc_timeout": rpcTimeoutAttribute(),
+		},
+	}
+}
+
+// Configure sets up the Cloud namespace search attribute resource configuration.
+func (r *CloudNamespaceSearchAttributeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring Temporal Cloud Namespace Search Attribute Resource")
+
+	// Prevent panic if the provider has not been configured yet
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TemporalClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			summaryResourceConfType,
+			fmt.Sprintf("Expected *provider.TemporalClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+	tflog.Info(ctx, "Configured Temporal Cloud Namespace Search Attribute client", map[string]any{"success": true})
+}
+
+// Create adds the search attribute to the spec of the Cloud namespace and waits for the change to be applied.
+func (r *CloudNamespaceSearchAttributeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.CloudNamespaceSearchAttributeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := r.client.timeOperation(ctx, "create", "temporal_cloud_namespace_search_attribute."+data.Namespace.ValueString()+":"+data.Name.ValueString())
+	defer done()
+	ctx = withRPCTimeout(ctx, data.RPCTimeout)
+
+	client, diags := r.client.cloud()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock := r.client.cloudNamespaces.lock(data.Namespace.ValueString())
+	defer unlock()
+
+	namespace, err := client.GetNamespace(ctx, &cloudservice.GetNamespaceRequest{Namespace: data.Namespace.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(summaryClientError, "Unable to read the Cloud namespace: "+requestErrorDetail(err))
+		return
+	}
+	spec := proto.Clone(namespace.GetNamespace().GetSpec()).(*cloudnamespace.NamespaceSpec)
+	if _, exists := spec.GetSearchAttributes()[data.Name.ValueString()]; exists {
+		resp.Diagnostics.AddError(summaryAlreadyExists,
+			fmt.Sprintf("Search attribute %s already exists in namespace %s. Import it with the ID %s:%s.",
+				data.Name.ValueString(), data.Namespace.ValueString(), data.Namespace.ValueString(), data.Name.ValueString()))
+		return
+	}
+	if spec.SearchAttributes == nil {
+		spec.SearchAttributes = make(map[string]cloudnamespace.NamespaceSpec_SearchAttributeType)
+	}
+	spec.SearchAttributes[data.Name.ValueString()] = cloudSearchAttributeTypes[data.Type.ValueString()]
+
+	update, err := client.UpdateNamespace(ctx, &cloudservice.UpdateNamespaceRequest{
+		Namespace:        data.Namespace.ValueString(),
+		Spec:             spec,
+		ResourceVersion:  namespace.GetNamespace().GetResourceVersion(),
+		AsyncOperationId: uuid.NewString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(summaryRequestError, "Search attribute creation failed: "+requestErrorDetail(err))
+		return
+	}
+	if err := temporalclient.AwaitCloudOperation(ctx, client, update.GetAsyncOperation(), cloudOperationPollInterval); err != nil {
+		resp.Diagnostics.AddError(summaryRequestError, "Error awaiting results: "+requestErrorDetail(err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("The search attribute: %s of type %s is successfully created", data.Name, data.Type.String()))
+}
+
+// Read refreshes the type of the search attribute from the spec of the Cloud namespace.
+func (r *CloudNamespaceSearchAttributeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.CloudNamespaceSearchAttributeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := r.client.timeOperation(ctx, "read", "temporal_cloud_namespace_search_attribute."+state.Namespace.ValueString()+":"+state.Name.ValueString())
+	defer done()
+	ctx = withRPCTimeout(ctx, state.RPCTimeout)
+
+	client, diags := r.client.cloud()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespace, err := client.GetNamespace(ctx, &cloudservice.GetNamespaceRequest{Namespace: state.Namespace.ValueString()})
+	if status.Code(err) == codes.NotFound {
+		tflog.Info(ctx, "Namespace not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(summaryClientError, fmt.Sprintf("Unable to read search attribute info, got error: %s", requestErrorDetail(err)))
+		return
+	}
+
+	attributeType, ok := namespace.GetNamespace().GetSpec().GetSearchAttributes()[state.Name.ValueString()]
+	if !ok {
+		// Delete resource from state if not found in underlying system
+		tflog.Info(ctx, "Resource not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.Type = cloudSearchAttributeTypeName(attributeType)
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Read a Temporal Cloud namespace search attribute resource")
+}
+
+// Update renames the search attribute. The namespace and type cannot change in place.
+func (r *CloudNamespaceSearchAttributeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.CloudNamespaceSearchAttributeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := r.client.timeOperation(ctx, "update", "temporal_cloud_namespace_search_attribute."+plan.Namespace.ValueString()+":"+plan.Name.ValueString())
+	defer done()
+	ctx = withRPCTimeout(ctx, plan.RPCTimeout)
+
+	if !plan.Name.Equal(state.Name) {
+		client, diags := r.client.cloud()
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		unlock := r.client.cloudNamespaces.lock(plan.Namespace.ValueString())
+		defer unlock()
+
+		namespace, err := client.GetNamespace(ctx, &cloudservice.GetNamespaceRequest{Namespace: plan.Namespace.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddError(summaryClientError, "Unable to read the Cloud namespace: "+requestErrorDetail(err))
+			return
+		}
+		rename, err := client.RenameCustomSearchAttribute(ctx, &cloudservice.RenameCustomSearchAttributeRequest{
+			Namespace:                         plan.Namespace.ValueString(),
+			ExistingCustomSearchAttributeName: state.Name.ValueString(),
+			NewCustomSearchAttributeName:      plan.Name.ValueString(),
+			ResourceVersion:                   namespace.GetNamespace().GetResourceVersion(),
+			AsyncOperationId:                  uuid.NewString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(summaryRequestError, fmt.Sprintf("Unable to rename search attribute %s to %s: %s", state.Name.ValueString(), plan.Name.ValueString(), requestErrorDetail(err)))
+			return
+		}
+		if err := temporalclient.AwaitCloudOperation(ctx, client, rename.GetAsyncOperation(), cloudOperationPollInterval); err != nil {
+			resp.Diagnostics.AddError(summaryRequestError, "Error awaiting results: "+requestErrorDetail(err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the search attribute from the state, as Temporal Cloud does not delete search attributes.
+func (r *CloudNamespaceSearchAttributeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data models.CloudNamespaceSearchAttributeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(summarySearchAttributeKept,
+		fmt.Sprintf("Temporal Cloud does not delete search attributes, so %s is kept in namespace %s and only removed from the Terraform state.",
+			data.Name.ValueString(), data.Namespace.ValueString()))
+}
+
+// ImportState imports a search attribute of a Cloud namespace with an ID of the form 'namespace:name', e.g.
+// 'orders.a2dd6:CustomerId'.
+func (r *CloudNamespaceSearchAttributeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	namespace, name, ok := strings.Cut(req.ID, ":")
+	if !ok || namespace == "" || name == "" {
+		resp.Diagnostics.AddError(summaryInvalidImportID, "Expected 'namespace:search_attribute_name', e.g. 'orders.a2dd6:CustomerId'.")
+		return
+	}
+
+	// The type is set by the read that follows the import.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
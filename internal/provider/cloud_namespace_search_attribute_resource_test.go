@@ -0,0 +1,58 @@
+package provider_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccCloudNamespaceSearchAttributeResource needs a Temporal Cloud namespace the API key in
+// TEMPORAL_CLOUD_API_KEY can change, set in TEMPORAL_CLOUD_TEST_NAMESPACE. Search attributes cannot be deleted
+// in Temporal Cloud, so every run leaves one behind.
+func TestAccCloudNamespaceSearchAttributeResource(t *testing.T) {
+	namespace := os.Getenv("TEMPORAL_CLOUD_TEST_NAMESPACE")
+	name := fmt.Sprintf("TerraformAcc%d", os.Getpid())
+	config := func(name string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "temporal_cloud_namespace_search_attribute" "test" {
+  namespace = %q
+  name      = %q
+  type      = "Keyword"
+}
+`, namespace, name)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if namespace == "" || os.Getenv("TEMPORAL_CLOUD_API_KEY") == "" {
+				t.Skip("TEMPORAL_CLOUD_TEST_NAMESPACE and TEMPORAL_CLOUD_API_KEY must be set")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_cloud_namespace_search_attribute.test", "name", name),
+					resource.TestCheckResourceAttr("temporal_cloud_namespace_search_attribute.test", "type", "Keyword"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "temporal_cloud_namespace_search_attribute.test",
+				ImportState:                          true,
+				ImportStateId:                        namespace + ":" + name,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Rename testing
+			{
+				Config: config(name + "Renamed"),
+				Check:  resource.TestCheckResourceAttr("temporal_cloud_namespace_search_attribute.test", "name", name+"Renamed"),
+			},
+		},
+	})
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"github.com/ganievs/terraform-provider-temporal/models"
+)
+
+// Ensures that ClusterInfoDataSource fully satisfies the datasource.DataSource and
+// datasource.DataSourceWithConfigure interfaces.
+var (
+	_ datasource.DataSource              = &ClusterInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &ClusterInfoDataSource{}
+)
+
+// NewClusterInfoDataSource returns a new instance of the ClusterInfoDataSource.
+func NewClusterInfoDataSource() datasource.DataSource {
+	return &ClusterInfoDataSource{}
+}
+
+// ClusterInfoDataSource implements the Terraform data source interface for Temporal cluster information.
+type ClusterInfoDataSource struct {
+	client         workflowservice.WorkflowServiceClient
+	operatorClient operatorservice.OperatorServiceClient
+}
+
+// Metadata sets the metadata for the Temporal cluster info data source, specifically the type name.
+func (d *ClusterInfoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_info"
+}
+
+// Schema defines the schema for the Temporal cluster info data source.
+func (d *ClusterInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Information about the connected Temporal cluster and the clusters it is connected to",
+
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the connected cluster",
+				Computed:            true,
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the connected cluster",
+				Computed:            true,
+			},
+			"server_version": schema.StringAttribute{
+				MarkdownDescription: "Temporal server version",
+				Computed:            true,
+			},
+			"history_shard_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of history shards",
+				Computed:            true,
+			},
+			"persistence_store": schema.StringAttribute{
+				MarkdownDescription: "Persistence store type",
+				Computed:            true,
+			},
+			"visibility_store": schema.StringAttribute{
+				MarkdownDescription: "Visibility store type",
+				Computed:            true,
+			},
+			"clusters": schema.ListNestedAttribute{
+				MarkdownDescription: "Clusters registered with the connected cluster, as returned by the Operator Service",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cluster_name": schema.StringAttribute{
+							MarkdownDescription: "Cluster name",
+							Computed:            true,
+						},
+						"cluster_id": schema.StringAttribute{
+							MarkdownDescription: "Cluster identifier",
+							Computed:            true,
+						},
+						"address": schema.StringAttribute{
+							MarkdownDescription: "Frontend gRPC address",
+							Computed:            true,
+						},
+						"http_address": schema.StringAttribute{
+							MarkdownDescription: "Frontend HTTP address",
+							Computed:            true,
+						},
+						"initial_failover_version": schema.Int64Attribute{
+							MarkdownDescription: "Initial failover version",
+							Computed:            true,
+						},
+						"history_shard_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of history shards",
+							Computed:            true,
+						},
+						"is_connection_enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether replication connections to the cluster are enabled",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure sets up the cluster info data source configuration.
+func (d *ClusterInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring Temporal Cluster Info DataSource")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	connection, ok := req.ProviderData.(*TemporalClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			summaryDataSourceConfType,
+			fmt.Sprintf("Expected *provider.TemporalClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = connection.workflowService
+	d.operatorClient = connection.operatorService
+
+	tflog.Info(ctx, "Configured Temporal Cluster Info client", map[string]any{"success": true})
+}
+
+// Read fetches information about the connected Temporal cluster and sets it in the Terraform state.
+func (d *ClusterInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Temporal Cluster Info")
+
+	info, err := d.client.GetClusterInfo(ctx, &workflowservice.GetClusterInfoRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError(summaryClientError, fmt.Sprintf("Unable to read cluster info, got error: %s", err))
+		return
+	}
+
+	data := &models.ClusterInfoDataSourceModel{
+		ClusterName:       types.StringValue(info.GetClusterName()),
+		ClusterId:         types.StringValue(info.GetClusterId()),
+		ServerVersion:     types.StringValue(info.GetServerVersion()),
+		HistoryShardCount: types.Int64Value(int64(info.GetHistoryShardCount())),
+		PersistenceStore:  types.StringValue(info.GetPersistenceStore()),
+		VisibilityStore:   types.StringValue(info.GetVisibilityStore()),
+		Clusters:          []models.ClusterMetadataModel{},
+	}
+
+	// Each page is retried on its own by the retry interceptor. A page that still fails keeps the pages before it.
+	var nextPageToken []byte
+	for pages := 0; ; pages++ {
+		clusters, err := d.operatorClient.ListClusters(ctx, &operatorservice.ListClustersRequest{
+			NextPageToken: nextPageToken,
+		})
+		if err != nil && pages > 0 && isTransient(err) {
+			resp.Diagnostics.AddWarning(summaryPartialList,
+				fmt.Sprintf("Listed %d clusters in %d pages, then reading the next page failed, so the clusters attribute is incomplete: %s",
+					len(data.Clusters), pages, requestErrorDetail(err)))
+			break
+		}
+		if err != nil {
+			// The WorkflowService answered above, so the Operator Service is most likely not routed to this endpoint.
+			if isServiceUnreachable(err) {
+				resp.Diagnostics.AddWarning(summaryOperatorServiceUnavailable,
+					fmt.Sprintf("Unable to list clusters, so the clusters attribute is left empty: %s", requestErrorDetail(err)))
+				data.Clusters = []models.ClusterMetadataModel{}
+				break
+			}
+			resp.Diagnostics.AddError(summaryClientError, fmt.Sprintf("Unable to list clusters, got error: %s", requestErrorDetail(err)))
+			return
+		}
+
+		for _, cluster := range clusters.GetClusters() {
+			data.Clusters = append(data.Clusters, models.ClusterMetadataModel{
+				ClusterName:            types.StringValue(cluster.GetClusterName()),
+				ClusterId:              types.StringValue(cluster.GetClusterId()),
+				Address:                types.StringValue(cluster.GetAddress()),
+				HttpAddress:            types.StringValue(cluster.GetHttpAddress()),
+				InitialFailoverVersion: types.Int64Value(cluster.GetInitialFailoverVersion()),
+				HistoryShardCount:      types.Int64Value(int64(cluster.GetHistoryShardCount())),
+				IsConnectionEnabled:    types.BoolValue(cluster.GetIsConnectionEnabled()),
+			})
+		}
+
+		nextPageToken = clusters.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,28 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClusterInfoDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "temporal_cluster_info" "current" {}
+`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.temporal_cluster_info.current", "cluster_name", "active"),
+					resource.TestCheckResourceAttrSet("data.temporal_cluster_info.current", "server_version"),
+					resource.TestCheckResourceAttrSet("data.temporal_cluster_info.current", "history_shard_count"),
+					resource.TestCheckResourceAttr("data.temporal_cluster_info.current", "clusters.0.cluster_name", "active"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.temporal.io/api/common/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// codecServerTimeout bounds each request to the codec server.
+const codecServerTimeout = 30 * time.Second
+
+// codecServerModel maps the codec_server block of the provider configuration.
+type codecServerModel struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+	Auth     types.String `tfsdk:"auth"`
+}
+
+// codecServer encodes payloads with a remote codec server, the same way the workers' payload codec does.
+// It speaks the protocol of the Temporal Web UI and CLI: payloads are POSTed as JSON to the /encode path,
+// with the namespace in the X-Namespace header.
+type codecServer struct {
+	endpoint string
+	auth     string
+	client   *http.Client
+}
+
+func newCodecServer(endpoint, auth string) *codecServer {
+	return &codecServer{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		auth:     auth,
+		client:   &http.Client{Timeout: codecServerTimeout},
+	}
+}
+
+// encode sends the payloads to the codec server and returns the encoded ones.
+func (c *codecServer) encode(ctx context.Context, namespace string, payloads *common.Payloads) (*common.Payloads, error) {
+	body, err := protojson.Marshal(payloads)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+"/encode", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Namespace", namespace)
+	if c.auth != "" {
+		req.Header.Set("Authorization", c.auth)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("codec server request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 4<<20))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the codec server response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("codec server returned %s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+	}
+
+	var encoded common.Payloads
+	if err := protojson.Unmarshal(respBody, &encoded); err != nil {
+		return nil, fmt.Errorf("unable to decode the codec server response: %w", err)
+	}
+	if len(encoded.GetPayloads()) != len(payloads.GetPayloads()) {
+		return nil, fmt.Errorf("codec server returned %d payloads for %d", len(encoded.GetPayloads()), len(payloads.GetPayloads()))
+	}
+	return &encoded, nil
+}
+
+// encodePayloads encodes payloads the provider sends to the server, such as workflow inputs, signal arguments
+// and memos. Without a codec server they are sent as they are.
+func (c *TemporalClient) encodePayloads(ctx context.Context, namespace string, payloads *common.Payloads) (*common.Payloads, error) {
+	if c.codec == nil || len(payloads.GetPayloads()) == 0 {
+		return payloads, nil
+	}
+	return c.codec.encode(ctx, namespace, payloads)
+}
@@ -1,13 +1,94 @@
 package provider
 
 import (
-	"go.temporal.io/api/enums/v1"
+	"errors"
+	"fmt"
+	"path"
+
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-var (
-	ArchivalState = map[string]enums.ArchivalState{
-		"Unspecified": enums.ARCHIVAL_STATE_UNSPECIFIED,
-		"Disabled":    enums.ARCHIVAL_STATE_DISABLED,
-		"Enabled":     enums.ARCHIVAL_STATE_ENABLED,
+// asNamespaceNotActive reports whether err was returned because the namespace is active in another cluster.
+func asNamespaceNotActive(err error) (*serviceerror.NamespaceNotActive, bool) {
+	if err == nil {
+		return nil, false
 	}
-)
+
+	var notActive *serviceerror.NamespaceNotActive
+	if errors.As(serviceerror.FromStatus(status.Convert(err)), &notActive) {
+		return notActive, true
+	}
+
+	return nil, false
+}
+
+// isServiceUnreachable reports whether err means that the endpoint does not serve the called gRPC service.
+// Load balancers that only route the WorkflowService answer calls to other services this way.
+func isServiceUnreachable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unimplemented, codes.Unavailable:
+		return true
+	default:
+		return false
+	}
+}
+
+// isTransient reports whether err is one the retry interceptor retries for reads, so a request that still failed
+// with it may succeed later. Other errors, e.g. a missing permission, fail every time.
+func isTransient(err error) bool {
+	switch status.Code(err) {
+	case codes.ResourceExhausted, codes.Unavailable, codes.Aborted, codes.DeadlineExceeded, codes.Internal:
+		return true
+	default:
+		return false
+	}
+}
+
+// requestErrorDetail renders err for a diagnostic, explaining where the namespace is active if the
+// request was sent to a standby cluster, and what to check if the endpoint does not serve the API.
+func requestErrorDetail(err error) string {
+	if status.Code(err) == codes.Unimplemented {
+		return err.Error() + "\n\nThe endpoint does not implement this API. If the frontend is reached through a load balancer or proxy, " +
+			"make sure it routes both temporal.api.workflowservice.v1.WorkflowService and temporal.api.operatorservice.v1.OperatorService."
+	}
+
+	notActive, ok := asNamespaceNotActive(err)
+	if !ok {
+		return err.Error()
+	}
+
+	var annotated *namespaceNotActiveError
+	if errors.As(err, &annotated) && annotated.address != "" {
+		return fmt.Sprintf("%s\n\nNamespace %q is active in cluster %q at %s, but the provider is connected to cluster %q, which did not forward %s to it. "+
+			"With the selected-apis-forwarding redirection policy only some APIs are forwarded to the active cluster. "+
+			"Point the provider at %s, or add %s = %q to cluster_addresses so requests are redirected.",
+			err.Error(), notActive.Namespace, notActive.ActiveCluster, annotated.address, notActive.CurrentCluster, path.Base(annotated.method),
+			annotated.address, notActive.ActiveCluster, annotated.address)
+	}
+
+	return fmt.Sprintf("%s\n\nNamespace %q is active in cluster %q, but the provider is connected to cluster %q. "+
+		"Point the provider at the active cluster, or add its frontend address to cluster_addresses so requests are redirected.",
+		err.Error(), notActive.Namespace, notActive.ActiveCluster, notActive.CurrentCluster)
+}
+
+// namespaceNotActiveError is a NamespaceNotActive error annotated with the frontend address of the active cluster.
+// It keeps the gRPC status of the original error.
+type namespaceNotActiveError struct {
+	err     error
+	method  string
+	address string
+}
+
+func (e *namespaceNotActiveError) Error() string {
+	return e.err.Error()
+}
+
+func (e *namespaceNotActiveError) Unwrap() error {
+	return e.err
+}
+
+func (e *namespaceNotActiveError) GRPCStatus() *status.Status {
+	return status.Convert(e.err)
+}
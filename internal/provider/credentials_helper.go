@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// credentialsHelperModel maps the credentials_helper block of the provider configuration.
+type credentialsHelperModel struct {
+	Command types.String `tfsdk:"command"`
+	Args    types.List   `tfsdk:"args"`
+	Env     types.Map    `tfsdk:"env"`
+}
+
+// runCredentialsHelper runs the credentials_helper command once and parses the credentials it prints. Like a
+// credentials source, the command prints either the API key alone or a JSON object.
+func runCredentialsHelper(ctx context.Context, m credentialsHelperModel) (secretCredentials, error) {
+	command, args, env, err := helperCommand(ctx, "credentials_helper", m.Command, m.Args, m.Env)
+	if err != nil {
+		return secretCredentials{}, err
+	}
+	output, err := runHelper(ctx, command, args, env)
+	if err != nil {
+		return secretCredentials{}, fmt.Errorf("credentials_helper %w", err)
+	}
+
+	var creds secretCredentials
+	if !strings.HasPrefix(output, "{") {
+		if output == "" {
+			return secretCredentials{}, fmt.Errorf("credentials_helper command %s printed no credentials", command)
+		}
+		creds.APIKey = output
+		return creds, nil
+	}
+	if err := json.Unmarshal([]byte(output), &creds); err != nil {
+		return secretCredentials{}, fmt.Errorf("credentials_helper command %s printed an invalid JSON object: %w", command, err)
+	}
+	return creds, nil
+}
@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// credentialsSourceTimeout bounds each request made to read the secret.
+const credentialsSourceTimeout = 30 * time.Second
+
+// gcpMetadataTokenURL returns an access token for the service account of the GCE instance, GKE workload or
+// Cloud Run service the provider runs in.
+const gcpMetadataTokenURL = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+
+// credentialsSourceModel maps the credentials_source block of the provider configuration.
+type credentialsSourceModel struct {
+	AWSSecretARN  types.String `tfsdk:"aws_secret_arn"`
+	GCPSecretName types.String `tfsdk:"gcp_secret_name"`
+}
+
+// secretCredentials are the settings read from a credentials source or printed by a credentials helper. A secret
+// that is not a JSON object is taken as the API key.
+type secretCredentials struct {
+	APIKey    string `json:"api_key"`
+	AuthToken string `json:"auth_token"`
+	TLSCert   string `json:"tls_cert"`
+	TLSKey    string `json:"tls_key"`
+	TLSCA     string `json:"tls_ca"`
+}
+
+// readCredentialsSource fetches the secret the credentials_source block points to.
+func readCredentialsSource(ctx context.Context, m credentialsSourceModel) (secretCredentials, error) {
+	var (
+		value string
+		err   error
+	)
+	switch {
+	case m.AWSSecretARN.ValueString() != "":
+		value, err = awsSecretValue(ctx, m.AWSSecretARN.ValueString())
+	case m.GCPSecretName.ValueString() != "":
+		value, err = gcpSecretValue(ctx, m.GCPSecretName.ValueString())
+	default:
+		return secretCredentials{}, errors.New("the credentials_source block requires aws_secret_arn or gcp_secret_name")
+	}
+	if err != nil {
+		return secretCredentials{}, err
+	}
+
+	var creds secretCredentials
+	if !strings.HasPrefix(strings.TrimSpace(value), "{") {
+		creds.APIKey = strings.TrimSpace(value)
+		return creds, nil
+	}
+	if err := json.Unmarshal([]byte(value), &creds); err != nil {
+		return secretCredentials{}, fmt.Errorf("the secret is not a valid JSON object: %w", err)
+	}
+	return creds, nil
+}
+
+// awsSecretValue reads the current version of a secret from AWS Secrets Manager. The region is taken from the ARN,
+// and the credentials from AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN.
+func awsSecretValue(ctx context.Context, arn string) (string, error) {
+	// arn:partition:secretsmanager:region:account-id:secret:name
+	parts := strings.SplitN(arn, ":", 7)
+	if len(parts) != 7 || parts[0] != "arn" || parts[2] != "secretsmanager" || parts[3] == "" {
+		return "", fmt.Errorf("%q is not a Secrets Manager secret ARN", arn)
+	}
+	region := parts[3]
+	host := "secretsmanager." + region + ".amazonaws.com"
+	if parts[1] == "aws-cn" {
+		host += ".cn"
+	}
+
+	accessKeyID, secretAccessKey := os.Getenv("AWS_ACCESS_KEY_ID"), os.Getenv("AWS_SECRET_ACCESS_KEY")
+	if accessKeyID == "" || secretAccessKey == "" {
+		return "", errors.New("AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set to read secrets from AWS Secrets Manager")
+	}
+
+	body, _ := json.Marshal(map[string]string{"SecretId": arn})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://"+host+"/", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+	if token := os.Getenv("AWS_SESSION_TOKEN"); token != "" {
+		req.Header.Set("X-Amz-Security-Token", token)
+	}
+	signAWSRequest(req, body, accessKeyID, secretAccessKey, region, "secretsmanager", time.Now().UTC())
+
+	var out struct {
+		SecretString string `json:"SecretString"`
+		SecretBinary []byte `json:"SecretBinary"`
+	}
+	if err := doSecretRequest(req, &out); err != nil {
+		return "", err
+	}
+	if out.SecretString == "" {
+		return string(out.SecretBinary), nil
+	}
+	return out.SecretString, nil
+}
+
+// signAWSRequest adds an AWS Signature Version 4 authorization header to a request with a body and no query.
+func signAWSRequest(req *http.Request, body []byte, accessKeyID, secretAccessKey, region, service string, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	signedHeaders := []string{"content-type", "host", "x-amz-date", "x-amz-target"}
+	if req.Header.Get("X-Amz-Security-Token") != "" {
+		signedHeaders = append(signedHeaders, "x-amz-security-token")
+	}
+	// Canonical headers are sorted by name.
+	sort.Strings(signedHeaders)
+	var canonicalHeaders strings.Builder
+	for _, name := range signedHeaders {
+		value := req.Header.Get(name)
+		if name == "host" {
+			value = req.URL.Host
+		}
+		fmt.Fprintf(&canonicalHeaders, "%s:%s\n", name, strings.TrimSpace(value))
+	}
+
+	bodyHash := sha256.Sum256(body)
+	canonicalRequest := strings.Join([]string{
+		req.Method, "/", "", canonicalHeaders.String(), strings.Join(signedHeaders, ";"), hex.EncodeToString(bodyHash[:]),
+	}, "\n")
+
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := []byte("AWS4" + secretAccessKey)
+	for _, part := range []string{date, region, service, "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKeyID, scope, strings.Join(signedHeaders, ";"), hex.EncodeToString(hmacSHA256(key, stringToSign))))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// gcpSecretValue reads a secret version from Google Secret Manager, the latest one if name has no version.
+// The access token is taken from GOOGLE_OAUTH_ACCESS_TOKEN, or from the metadata server when it is not set.
+func gcpSecretValue(ctx context.Context, name string) (string, error) {
+	if !strings.HasPrefix(name, "projects/") || !strings.Contains(name, "/secrets/") {
+		return "", fmt.Errorf("%q is not a Secret Manager secret name, expected projects/PROJECT/secrets/SECRET[/versions/VERSION]", name)
+	}
+	if !strings.Contains(name, "/versions/") {
+		name += "/versions/latest"
+	}
+
+	token := os.Getenv("GOOGLE_OAUTH_ACCESS_TOKEN")
+	if token == "" {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, gcpMetadataTokenURL, nil)
+		if err != nil {
+			return "", err
+		}
+		req.Header.Set("Metadata-Flavor", "Google")
+
+		var out struct {
+			AccessToken string `json:"access_token"`
+		}
+		if err := doSecretRequest(req, &out); err != nil {
+			return "", fmt.Errorf("GOOGLE_OAUTH_ACCESS_TOKEN is not set and no token could be obtained from the metadata server: %w", err)
+		}
+		token = out.AccessToken
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://secretmanager.googleapis.com/v1/"+name+":access", nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	var out struct {
+		Payload struct {
+			Data string `json:"data"`
+		} `json:"payload"`
+	}
+	if err := doSecretRequest(req, &out); err != nil {
+		return "", err
+	}
+
+	data, err := base64.StdEncoding.DecodeString(out.Payload.Data)
+	if err != nil {
+		return "", fmt.Errorf("unable to decode the secret payload: %w", err)
+	}
+	return string(data), nil
+}
+
+// doSecretRequest sends req and decodes the JSON response into out.
+func doSecretRequest(req *http.Request, out any) error {
+	client := &http.Client{Timeout: credentialsSourceTimeout}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s %s returned %s: %s", req.Method, req.URL.Host, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return json.Unmarshal(body, out)
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"github.com/ganievs/terraform-provider-temporal/models"
+)
+
+// devServerNamespace is the namespace the dev server, temporal server start-dev, creates on startup.
+const devServerNamespace = "default"
+
+const (
+	// propagationPollInterval is how often the provider checks whether a change has reached every frontend, e.g.
+	// whether a newly registered namespace accepts requests.
+	propagationPollInterval = time.Second
+
+	// devModePollInterval replaces propagationPollInterval in dev_mode. The dev server is a single process, so
+	// changes are visible almost at once.
+	devModePollInterval = 100 * time.Millisecond
+)
+
+// devModeKey is the context key that marks operations of a provider in dev_mode.
+type devModeKey struct{}
+
+// withDevMode returns a context whose propagation waits are tuned for the dev server when enabled.
+func withDevMode(ctx context.Context, enabled bool) context.Context {
+	if !enabled {
+		return ctx
+	}
+	return context.WithValue(ctx, devModeKey{}, true)
+}
+
+// propagationInterval returns how often to check whether a change has propagated.
+func propagationInterval(ctx context.Context) time.Duration {
+	if enabled, _ := ctx.Value(devModeKey{}).(bool); enabled {
+		return devModePollInterval
+	}
+	return propagationPollInterval
+}
+
+// devModeRegisterRequest drops the archival and replication settings of a namespace registration. The dev server
+// has neither archival nor multi-cluster replication configured, and rejects namespaces that ask for them.
+func devModeRegisterRequest(request *workflowservice.RegisterNamespaceRequest) {
+	request.HistoryArchivalState = enums.ARCHIVAL_STATE_UNSPECIFIED
+	request.HistoryArchivalUri = ""
+	request.VisibilityArchivalState = enums.ARCHIVAL_STATE_UNSPECIFIED
+	request.VisibilityArchivalUri = ""
+	request.ActiveClusterName = ""
+	request.IsGlobalNamespace = false
+}
+
+// devModeUpdateRequest drops the archival and replication settings of a namespace update, see
+// devModeRegisterRequest.
+func devModeUpdateRequest(request *workflowservice.UpdateNamespaceRequest) {
+	request.Config.HistoryArchivalState = enums.ARCHIVAL_STATE_UNSPECIFIED
+	request.Config.HistoryArchivalUri = ""
+	request.Config.VisibilityArchivalState = enums.ARCHIVAL_STATE_UNSPECIFIED
+	request.Config.VisibilityArchivalUri = ""
+	request.ReplicationConfig = nil
+	request.PromoteNamespace = false
+}
+
+// keepDevModeSettings copies the archival and replication settings that dev_mode does not send to the server from
+// the planned or prior model, so that the configuration matches the state as it would in production. Settings the
+// model leaves open, e.g. after an import, keep what the server reported.
+func keepDevModeSettings(data *models.NamespaceResourceModel, configured models.NamespaceResourceModel) {
+	known := func(value attr.Value) bool {
+		return !value.IsNull() && !value.IsUnknown()
+	}
+	if known(configured.ActiveClusterName) {
+		data.ActiveClusterName = configured.ActiveClusterName
+	}
+	if known(configured.HistoryArchivalState) {
+		data.HistoryArchivalState = configured.HistoryArchivalState
+	}
+	if known(configured.HistoryArchivalUri) {
+		data.HistoryArchivalUri = configured.HistoryArchivalUri
+	}
+	if known(configured.VisibilityArchivalState) {
+		data.VisibilityArchivalState = configured.VisibilityArchivalState
+	}
+	if known(configured.VisibilityArchivalUri) {
+		data.VisibilityArchivalUri = configured.VisibilityArchivalUri
+	}
+	if known(configured.IsGlobalNamespace) {
+		data.IsGlobalNamespace = configured.IsGlobalNamespace
+	}
+}
+
+// adoptDevServerNamespace applies the settings of a namespace registration to the namespace the dev server created,
+// instead of failing because it already exists.
+func adoptDevServerNamespace(ctx context.Context, client workflowservice.WorkflowServiceClient, request *workflowservice.RegisterNamespaceRequest) error {
+	_, err := client.UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+		Namespace: request.GetNamespace(),
+		UpdateInfo: &namespace.UpdateNamespaceInfo{
+			Description: request.GetDescription(),
+			OwnerEmail:  request.GetOwnerEmail(),
+			Data:        request.GetData(),
+		},
+		Config: &namespace.NamespaceConfig{
+			WorkflowExecutionRetentionTtl: request.GetWorkflowExecutionRetentionPeriod(),
+		},
+	})
+	return err
+}
@@ -0,0 +1,111 @@
+package provider
+
+// Diagnostic summaries start with a stable code, so that errors can be matched by automated triage and
+// looked up in a knowledge base regardless of how the detail is worded. Codes are never reused or renumbered;
+// add new ones at the end of their range.
+//
+// 0xx codes are about the provider configuration, 1xx about requests to the Temporal API and 2xx are warnings.
+const (
+	summaryUnknownHost           = "TEMPORAL-PROV-001: Unknown Temporal Frontend Host"
+	summaryUnknownPort           = "TEMPORAL-PROV-002: Unknown Temporal Frontend Port"
+	summaryUnknownClientID       = "TEMPORAL-PROV-003: Unknown Temporal Client ID"
+	summaryUnknownClientSecret   = "TEMPORAL-PROV-004: Unknown Temporal Client Secret"
+	summaryUnknownTokenURL       = "TEMPORAL-PROV-005: Unknown Oauth2 Token URL"
+	summaryUnknownAudience       = "TEMPORAL-PROV-006: Unknown Audience"
+	summaryUnknownInsecure       = "TEMPORAL-PROV-007: Unknown Insecure"
+	summaryUnknownClusterAddrs   = "TEMPORAL-PROV-008: Unknown Cluster Addresses"
+	summaryUnknownLogCLI         = "TEMPORAL-PROV-009: Unknown Log CLI Commands"
+	summaryUnknownAPIKey         = "TEMPORAL-PROV-010: Unknown API Key"
+	summaryUnknownAuthToken      = "TEMPORAL-PROV-011: Unknown Auth Token"
+	summaryUnknownOAuth2         = "TEMPORAL-PROV-012: Unknown OAuth2 Settings"
+	summaryUnknownHeaders        = "TEMPORAL-PROV-013: Unknown Headers"
+	summaryUnknownMaxDeletes     = "TEMPORAL-PROV-014: Unknown Max Delete Operations"
+	summaryUnknownAddress        = "TEMPORAL-PROV-015: Unknown Temporal Frontend Address"
+	summaryUnknownConnectTimeout = "TEMPORAL-PROV-016: Unknown Connect Timeout"
+	summaryUnknownTimings        = "TEMPORAL-PROV-017: Unknown Timings"
+	summaryUnknownCredsSource    = "TEMPORAL-PROV-018: Unknown Credentials Source"
+	summaryUnknownProxyURL       = "TEMPORAL-PROV-019: Unknown Proxy URL"
+
+	summaryInvalidInsecure       = "TEMPORAL-PROV-020: Invalid Insecure"
+	summaryInvalidLogCLI         = "TEMPORAL-PROV-021: Invalid Log CLI Commands"
+	summaryInvalidDuration       = "TEMPORAL-PROV-022: Invalid Duration"
+	summaryInvalidBucketSize     = "TEMPORAL-PROV-023: Invalid Bucket Size"
+	summaryConflictingAuth       = "TEMPORAL-PROV-024: Conflicting Authentication"
+	summaryMissingKerberosSPN    = "TEMPORAL-PROV-025: Missing Kerberos Service Principal"
+	summaryAPIKeyRequiresTLS     = "TEMPORAL-PROV-026: API Key Requires TLS"
+	summaryDuplicateNamespaceKey = "TEMPORAL-PROV-027: Duplicate Namespace Data Key"
+	summaryMissingOAuth2Setting  = "TEMPORAL-PROV-028: Missing OAuth2 Setting"
+	summaryTooManyDeletions      = "TEMPORAL-PROV-029: Too Many Deletions"
+	summaryInvalidAddress        = "TEMPORAL-PROV-039: Invalid Address"
+	summaryInvalidEnvVar         = "TEMPORAL-PROV-040: Invalid Environment Variable"
+	summaryInvalidTLSVersion     = "TEMPORAL-PROV-041: Invalid TLS Version"
+	summaryConnect               = "TEMPORAL-PROV-042: Unable to Connect"
+	summaryReadCredentialsSource = "TEMPORAL-PROV-043: Unable to Read Credentials Source"
+	summaryInvalidProxyURL       = "TEMPORAL-PROV-044: Invalid Proxy URL"
+	summaryUnknownEndpoint       = "TEMPORAL-PROV-045: Unknown Endpoint"
+	summaryUnknownEndpoints      = "TEMPORAL-PROV-046: Unknown Endpoints"
+	summaryUnknownCodecServer    = "TEMPORAL-PROV-047: Unknown Codec Server"
+	summaryUnknownExperiments    = "TEMPORAL-PROV-048: Unknown Experiments"
+	summaryExperimentNotEnabled  = "TEMPORAL-PROV-049: Experiment Not Enabled"
+	summaryUnknownSkipHealth     = "TEMPORAL-PROV-050: Unknown Skip Health Check"
+	summaryUnauthorized          = "TEMPORAL-PROV-051: Unauthorized"
+	summaryUnknownNamespace      = "TEMPORAL-PROV-052: Unknown Namespace"
+	summaryUnknownCloudNamespace = "TEMPORAL-PROV-053: Unknown Cloud Namespace"
+	summaryCloudRequiresTLS      = "TEMPORAL-PROV-054: Temporal Cloud Requires TLS"
+	summaryUnknownWorkspace      = "TEMPORAL-PROV-055: Unknown Managed By Workspace"
+	summaryUnknownIdentity       = "TEMPORAL-PROV-056: Unknown Identity"
+	summaryUnknownLoadBalancing  = "TEMPORAL-PROV-057: Unknown Load Balancing Policy"
+	summaryUnknownRPCTimeout     = "TEMPORAL-PROV-058: Unknown RPC Timeout"
+	summaryUnknownMaxRequests    = "TEMPORAL-PROV-059: Unknown Max Requests Per Second"
+	summaryUnknownDNSResolver    = "TEMPORAL-PROV-060: Unknown DNS Resolver"
+	summaryUnknownTransport      = "TEMPORAL-PROV-061: Unknown Transport"
+	summaryHTTPUnsupported       = "TEMPORAL-PROV-062: Setting Not Supported Over HTTP"
+	summaryInvalidArchival       = "TEMPORAL-PROV-063: Invalid Archival State"
+	summaryUnknownTracing        = "TEMPORAL-PROV-064: Unknown Tracing"
+	summaryTracingSetup          = "TEMPORAL-PROV-065: Unable to Set Up Tracing"
+	summaryUnknownRPCLogLevel    = "TEMPORAL-PROV-066: Unknown RPC Log Level"
+	summaryUnknownLogPayloads    = "TEMPORAL-PROV-067: Unknown Log RPC Payloads"
+	summaryRequiresTLS           = "TEMPORAL-PROV-068: Setting Requires TLS"
+	summaryIncompleteClientCert  = "TEMPORAL-PROV-069: Incomplete Client Certificate"
+	summarySecretReference       = "TEMPORAL-PROV-070: Unable to Resolve Secret Reference"
+	summaryAuthExecSetup         = "TEMPORAL-PROV-071: Unable to Set Up auth_exec"
+	summaryUnknownCredsHelper    = "TEMPORAL-PROV-072: Unknown Credentials Helper"
+	summaryRunCredentialsHelper  = "TEMPORAL-PROV-073: Unable to Run Credentials Helper"
+	summaryUnknownCloudAPIKey    = "TEMPORAL-PROV-074: Unknown Cloud API Key"
+	summaryUnknownCloudAPIAddr   = "TEMPORAL-PROV-075: Unknown Cloud API Address"
+	summaryCloudAPINotConfigured = "TEMPORAL-PROV-076: Cloud API Not Configured"
+	summaryUnknownDevMode        = "TEMPORAL-PROV-077: Unknown Dev Mode"
+
+	summaryInvalidClientCert  = "TEMPORAL-PROV-030: Invalid Client Certificate"
+	summaryReadClientCert     = "TEMPORAL-PROV-031: Unable to Read Client Certificate"
+	summaryReadPrivateKey     = "TEMPORAL-PROV-032: Unable to Read Private Key"
+	summaryReadCACerts        = "TEMPORAL-PROV-033: Unable to Read CA Certificates"
+	summaryInvalidCACerts     = "TEMPORAL-PROV-034: Invalid CA Certificates"
+	summaryKerberosSetup      = "TEMPORAL-PROV-035: Unable to Set Up Kerberos Authentication"
+	summaryCreateClient       = "TEMPORAL-PROV-036: Unable to Create Temporal API Client"
+	summaryDataSourceConfType = "TEMPORAL-PROV-037: Unexpected Data Source Configure Type"
+	summaryResourceConfType   = "TEMPORAL-PROV-038: Unexpected Resource Configure Type"
+
+	summaryClientError                = "TEMPORAL-PROV-100: Client Error"
+	summaryRequestError               = "TEMPORAL-PROV-101: Request Error"
+	summaryNotFound                   = "TEMPORAL-PROV-102: Not Found"
+	summaryAlreadyExists              = "TEMPORAL-PROV-103: Already Exists"
+	summaryInvalidImportID            = "TEMPORAL-PROV-104: Invalid ID Format"
+	summaryNamespaceAlreadyRegistered = "TEMPORAL-PROV-105: Namespace Already Registered"
+	summarySnapshot                   = "TEMPORAL-PROV-106: Unable to Write Namespace Snapshot"
+	summaryServerTooOld               = "TEMPORAL-PROV-107: Server Too Old"
+	summaryInvalidPrivateState        = "TEMPORAL-PROV-108: Invalid Private State"
+
+	summaryOperatorServiceUnavailable = "TEMPORAL-PROV-200: Operator Service Unavailable"
+	summaryNamespaceDeprecated        = "TEMPORAL-PROV-201: Namespace Deprecated"
+	summaryNamespaceKeyNotRemoved     = "TEMPORAL-PROV-202: Namespace Data Key Not Removed"
+	summaryValueNormalized            = "TEMPORAL-PROV-203: Value Normalized by Server"
+	summaryTLSVerifyDisabled          = "TEMPORAL-PROV-204: TLS Certificate Verification Disabled"
+	summaryAuthTokenWithoutTLS        = "TEMPORAL-PROV-205: Auth Token Sent Without TLS"
+	summaryChaosEnabled               = "TEMPORAL-PROV-206: Failure Injection Enabled"
+	summaryPlaintextConnection        = "TEMPORAL-PROV-207: Plaintext Connection"
+	summarySearchAttributeKept        = "TEMPORAL-PROV-208: Search Attribute Kept"
+	summaryDeprecatedEnvVar           = "TEMPORAL-PROV-209: Deprecated Environment Variable"
+	summaryDefaultNamespaceKept       = "TEMPORAL-PROV-210: Default Namespace Kept"
+	summaryPartialList                = "TEMPORAL-PROV-211: Partial List"
+)
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"google.golang.org/grpc/resolver"
+)
+
+// DNS resolvers of the dns_resolver attribute.
+const (
+	dnsResolverGRPC   = "grpc"
+	dnsResolverGo     = "go"
+	dnsResolverSystem = "system"
+)
+
+// dnsLookupTimeout bounds each lookup of the go resolver.
+const dnsLookupTimeout = 10 * time.Second
+
+// dnsResolverBuilder returns the resolver of frontend names for a dns_resolver value.
+func dnsResolverBuilder(mode string) (resolver.Builder, error) {
+	switch mode {
+	case "", dnsResolverGRPC:
+		return resolver.Get("dns"), nil
+	case dnsResolverGo:
+		return &netResolverBuilder{resolver: &net.Resolver{PreferGo: true}}, nil
+	case dnsResolverSystem:
+		return &netResolverBuilder{}, nil
+	default:
+		return nil, fmt.Errorf("unknown DNS resolver %q, expected %s, %s or %s", mode, dnsResolverGRPC, dnsResolverGo, dnsResolverSystem)
+	}
+}
+
+// netResolverBuilder replaces the gRPC DNS resolver. With a resolver, names are looked up with it. Without one,
+// the name is passed on unresolved, so the operating system resolves it when the connection is made, the way
+// other programs on the host do, including split-horizon DNS on Windows.
+type netResolverBuilder struct {
+	resolver *net.Resolver
+}
+
+// Build resolves the target for the first time.
+func (b *netResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, opts resolver.BuildOptions) (resolver.Resolver, error) {
+	r := &netResolver{resolver: b.resolver, endpoint: target.Endpoint(), cc: cc}
+	r.ResolveNow(resolver.ResolveNowOptions{})
+	return r, nil
+}
+
+// Scheme returns the scheme of the gRPC DNS resolver, which it replaces.
+func (b *netResolverBuilder) Scheme() string {
+	return "dns"
+}
+
+// netResolver resolves one frontend address.
+type netResolver struct {
+	resolver *net.Resolver
+	endpoint string
+	cc       resolver.ClientConn
+}
+
+// ResolveNow looks the name up again and reports the addresses to gRPC.
+func (r *netResolver) ResolveNow(resolver.ResolveNowOptions) {
+	if r.resolver == nil {
+		_ = r.cc.UpdateState(resolver.State{Addresses: []resolver.Address{{Addr: r.endpoint}}})
+		return
+	}
+
+	host, port, err := net.SplitHostPort(r.endpoint)
+	if err != nil {
+		r.cc.ReportError(err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dnsLookupTimeout)
+	defer cancel()
+	hosts, err := r.resolver.LookupHost(ctx, host)
+	if err != nil {
+		r.cc.ReportError(err)
+		return
+	}
+
+	addresses := make([]resolver.Address, 0, len(hosts))
+	for _, host := range hosts {
+		addresses = append(addresses, resolver.Address{Addr: net.JoinHostPort(host, port)})
+	}
+	_ = r.cc.UpdateState(resolver.State{Addresses: addresses})
+}
+
+// Close does nothing, the resolver holds no resources.
+func (r *netResolver) Close() {}
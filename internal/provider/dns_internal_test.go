@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"testing"
+
+	"google.golang.org/grpc/resolver"
+)
+
+// fakeResolverClientConn records what a resolver reports.
+type fakeResolverClientConn struct {
+	resolver.ClientConn
+	state resolver.State
+	err   error
+}
+
+func (c *fakeResolverClientConn) UpdateState(state resolver.State) error {
+	c.state = state
+	return nil
+}
+
+func (c *fakeResolverClientConn) ReportError(err error) {
+	c.err = err
+}
+
+func TestDNSResolverBuilder(t *testing.T) {
+	tests := []struct {
+		mode        string
+		wantNet     bool
+		wantLookups bool
+		wantErr     bool
+	}{
+		{mode: ""},
+		{mode: dnsResolverGRPC},
+		{mode: dnsResolverGo, wantNet: true, wantLookups: true},
+		{mode: dnsResolverSystem, wantNet: true},
+		{mode: "cgo", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			builder, err := dnsResolverBuilder(tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("dnsResolverBuilder(%q) error = %v, wantErr %t", tt.mode, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if builder.Scheme() != "dns" {
+				t.Errorf("Scheme() = %q, want dns", builder.Scheme())
+			}
+			netBuilder, isNet := builder.(*netResolverBuilder)
+			if isNet != tt.wantNet {
+				t.Fatalf("dnsResolverBuilder(%q) = %T", tt.mode, builder)
+			}
+			if isNet && (netBuilder.resolver != nil) != tt.wantLookups {
+				t.Errorf("dnsResolverBuilder(%q) looks names up = %t, want %t", tt.mode, netBuilder.resolver != nil, tt.wantLookups)
+			}
+		})
+	}
+}
+
+func TestNetResolver(t *testing.T) {
+	errNoDNS := errors.New("no DNS in tests")
+	offline := &net.Resolver{
+		PreferGo: true,
+		Dial: func(context.Context, string, string) (net.Conn, error) {
+			return nil, errNoDNS
+		},
+	}
+
+	tests := []struct {
+		name     string
+		resolver *net.Resolver
+		endpoint string
+		want     string
+		wantErr  bool
+	}{
+		{name: "system passes the name on", endpoint: "temporal.example.com:7233", want: "temporal.example.com:7233"},
+		{name: "go resolves addresses", resolver: offline, endpoint: "127.0.0.1:7233", want: "127.0.0.1:7233"},
+		{name: "go keeps the port of IPv6 addresses", resolver: offline, endpoint: "[::1]:7233", want: "[::1]:7233"},
+		{name: "go without a port", resolver: offline, endpoint: "temporal.example.com", wantErr: true},
+		{name: "go lookup failure", resolver: offline, endpoint: "temporal.invalid:7233", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cc := &fakeResolverClientConn{}
+			r := &netResolver{resolver: tt.resolver, endpoint: tt.endpoint, cc: cc}
+			r.ResolveNow(resolver.ResolveNowOptions{})
+
+			if (cc.err != nil) != tt.wantErr {
+				t.Fatalf("ResolveNow() reported error %v, wantErr %t", cc.err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(cc.state.Addresses) != 1 || cc.state.Addresses[0].Addr != tt.want {
+				t.Errorf("ResolveNow() addresses = %v, want %s", cc.state.Addresses, tt.want)
+			}
+		})
+	}
+}
+
+func TestNetResolverBuilderBuild(t *testing.T) {
+	cc := &fakeResolverClientConn{}
+	target := resolver.Target{URL: url.URL{Scheme: "dns", Path: "/temporal.example.com:7233"}}
+	r, err := (&netResolverBuilder{}).Build(target, cc, resolver.BuildOptions{})
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	defer r.Close()
+
+	if len(cc.state.Addresses) != 1 || cc.state.Addresses[0].Addr != "temporal.example.com:7233" {
+		t.Errorf("Build() addresses = %v, want the endpoint resolved right away", cc.state.Addresses)
+	}
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"google.golang.org/grpc"
+
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// endpointModel maps an entry of the endpoints attribute: another cluster managed through the same provider.
+type endpointModel struct {
+	Address   types.String `tfsdk:"address"`
+	Insecure  types.Bool   `tfsdk:"insecure"`
+	APIKey    types.String `tfsdk:"api_key"`
+	AuthToken types.String `tfsdk:"auth_token"`
+	TLS       types.Object `tfsdk:"tls"`
+}
+
+// endpointTLSModel maps the tls attribute of an endpoint, a subset of the provider's tls block.
+type endpointTLSModel struct {
+	Cert       types.String `tfsdk:"cert"`
+	Key        types.String `tfsdk:"key"`
+	CertPath   types.String `tfsdk:"cert_path"`
+	KeyPath    types.String `tfsdk:"key_path"`
+	CA         types.String `tfsdk:"ca"`
+	CAPath     types.String `tfsdk:"ca_path"`
+	ServerName types.String `tfsdk:"server_name"`
+	SkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+}
+
+// endpointsAttribute returns the schema of the endpoints provider attribute.
+func endpointsAttribute() schema.MapNestedAttribute {
+	return schema.MapNestedAttribute{
+		Optional: true,
+		Description: "Other clusters managed through this provider, by name. Resources select one with their `cluster` attribute, and use the provider's own connection when it is not set. " +
+			"Each endpoint has its own address, TLS settings and credentials; `headers`, `proxy_url`, `connect_params`, `log_cli_commands` and `timings` apply to all of them.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"address": schema.StringAttribute{
+					Required:    true,
+					Description: "Address of the frontend, e.g. \"temporal-standby.example.com:7233\".",
+					Validators:  []validator.String{isHostPort()},
+				},
+				"insecure": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Connect without TLS.",
+				},
+				"api_key": schema.StringAttribute{
+					Optional:    true,
+					Sensitive:   true,
+					Description: "API key sent as a bearer token. Requires TLS.",
+				},
+				"auth_token": schema.StringAttribute{
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Static token sent in the authorization header, e.g. a JWT.",
+				},
+				"tls": schema.SingleNestedAttribute{
+					Optional:    true,
+					Description: "TLS settings of the endpoint. System roots are used when no CA is set.",
+					Attributes: map[string]schema.Attribute{
+						"cert":                 schema.StringAttribute{Optional: true, Description: "PEM encoded client certificate."},
+						"key":                  schema.StringAttribute{Optional: true, Sensitive: true, Description: "PEM encoded private key of the client certificate."},
+						"cert_path":            schema.StringAttribute{Optional: true, Description: "Path to the PEM encoded client certificate."},
+						"key_path":             schema.StringAttribute{Optional: true, Description: "Path to the PEM encoded private key of the client certificate."},
+						"ca":                   schema.StringAttribute{Optional: true, Description: "PEM encoded CA certificates to verify the server certificate with."},
+						"ca_path":              schema.StringAttribute{Optional: true, Description: "Path to the PEM encoded CA certificates to verify the server certificate with."},
+						"server_name":          schema.StringAttribute{Optional: true, Description: "Server name to verify the certificate against, when it differs from the address."},
+						"insecure_skip_verify": schema.BoolAttribute{Optional: true, Description: "Do not verify the server certificate. Only for test environments."},
+					},
+				},
+			},
+		},
+	}
+}
+
+// clusterAttribute returns the schema of the cluster attribute of resources. Moving an object to another
+// cluster means creating it there, so changing the attribute replaces the resource.
+func clusterAttribute() resourceschema.StringAttribute {
+	return resourceschema.StringAttribute{
+		MarkdownDescription: "Name of the provider `endpoints` entry of the cluster to manage the object in. Defaults to the provider's own connection.",
+		Optional:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// newEndpointClients creates a client for every named endpoint. opts are added to every connection.
+func newEndpointClients(ctx context.Context, endpoints map[string]endpointModel, opts ...grpc.DialOption) (map[string]*TemporalClient, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	clients := make(map[string]*TemporalClient, len(endpoints))
+
+	for name, endpoint := range endpoints {
+		base := path.Root("endpoints").AtMapKey(name)
+		endpointOpts := opts[:len(opts):len(opts)]
+
+		if endpoint.APIKey.ValueString() != "" && endpoint.AuthToken.ValueString() != "" {
+			diags.AddAttributeError(base.AtName("auth_token"), summaryConflictingAuth,
+				"An auth token cannot be combined with an API key, both set the authorization header.")
+			continue
+		}
+		if endpoint.APIKey.ValueString() != "" {
+			if endpoint.Insecure.ValueBool() {
+				diags.AddAttributeError(base.AtName("api_key"), summaryAPIKeyRequiresTLS,
+					"The API key is only sent over TLS connections. Remove the insecure option to use it.")
+				continue
+			}
+			endpointOpts = append(endpointOpts, grpc.WithPerRPCCredentials(apiKeyCredentials(endpoint.APIKey.ValueString())))
+		}
+		if endpoint.AuthToken.ValueString() != "" {
+			if endpoint.Insecure.ValueBool() {
+				diags.AddAttributeWarning(base.AtName("auth_token"), summaryAuthTokenWithoutTLS,
+					"The auth token is sent over a plaintext connection and can be read by anyone on the network path to the frontend.")
+			}
+			endpointOpts = append(endpointOpts, grpc.WithPerRPCCredentials(authTokenCredentials(endpoint.AuthToken.ValueString())))
+		}
+
+		tlsConfig, tlsDiags := endpoint.tlsConfig(ctx, base.AtName("tls"))
+		diags.Append(tlsDiags...)
+		if tlsDiags.HasError() {
+			continue
+		}
+
+		conn, err := CreateGRPCClient("", "", "", "", endpoint.Address.ValueString(), endpoint.Insecure.ValueBool(), tlsConfig, endpointOpts...)
+		if err != nil {
+			diags.AddAttributeError(base, summaryCreateClient,
+				fmt.Sprintf("Unable to create the Temporal API client for endpoint %q: %s", name, err))
+			continue
+		}
+		clients[name] = newTemporalClient(conn)
+		clients[name].connection = connectionInfo{
+			address:           endpoint.Address.ValueString(),
+			authMode:          authMode("", endpoint.APIKey.ValueString(), endpoint.AuthToken.ValueString(), false, false),
+			tls:               !endpoint.Insecure.ValueBool(),
+			clientCertificate: hasClientCertificate(tlsConfig),
+		}
+	}
+
+	return clients, diags
+}
+
+// tlsConfig builds the TLS configuration of the endpoint, nil for the default settings.
+func (m endpointModel) tlsConfig(ctx context.Context, base path.Path) (*tls.Config, diag.Diagnostics) {
+	if m.TLS.IsNull() || m.Insecure.ValueBool() {
+		return nil, nil
+	}
+
+	var endpointTLS endpointTLSModel
+	diags := m.TLS.As(ctx, &endpointTLS, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	config, tlsDiags := newTLSConfig(ctx, tlsModel{
+		Cert:       endpointTLS.Cert,
+		Key:        endpointTLS.Key,
+		CertPath:   endpointTLS.CertPath,
+		KeyPath:    endpointTLS.KeyPath,
+		CA:         endpointTLS.CA,
+		CAPath:     endpointTLS.CAPath,
+		ServerName: endpointTLS.ServerName,
+		SkipVerify: endpointTLS.SkipVerify,
+	}, base)
+	diags.Append(tlsDiags...)
+	return config, diags
+}
+
+// cluster returns the client of the named endpoint, or c itself if no cluster is set.
+func (c *TemporalClient) cluster(name types.String) (*TemporalClient, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if name.ValueString() == "" {
+		return c, diags
+	}
+
+	client, ok := c.endpoints[name.ValueString()]
+	if !ok {
+		names := make([]string, 0, len(c.endpoints))
+		for endpoint := range c.endpoints {
+			names = append(names, endpoint)
+		}
+		sort.Strings(names)
+		diags.AddAttributeError(path.Root("cluster"), summaryUnknownEndpoint,
+			fmt.Sprintf("The provider has no endpoint named %q. Known endpoints: %s.", name.ValueString(), strings.Join(names, ", ")))
+		return nil, diags
+	}
+	return client, diags
+}
+
+// splitClusterImportID splits an import ID of the form 'id@cluster' into the ID and the cluster, if any.
+func splitClusterImportID(id string) (string, types.String) {
+	if i := strings.LastIndex(id, "@"); i >= 0 {
+		return id[:i], types.StringValue(id[i+1:])
+	}
+	return id, types.StringNull()
+}
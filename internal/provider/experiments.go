@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Experiments gate resources and data sources built on server APIs that are still changing, so that using them is
+// an explicit decision and changes to them cannot affect configurations that only manage namespaces.
+const (
+	experimentWorkerVersioning = "worker_versioning"
+)
+
+// experiments registers every experiment with what it enables. Experimental resources and data sources are
+// always part of the schema, so they are documented, but fail to configure until their experiment is enabled.
+var experiments = map[string]string{
+	experimentWorkerVersioning: "the temporal_task_queue_default_build_id resource, which uses the worker versioning API of Temporal 1.21 and later",
+}
+
+// experimentNames returns the names of all experiments, sorted.
+func experimentNames() []string {
+	names := make([]string, 0, len(experiments))
+	for name := range experiments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// envExperiments returns the experiments enabled with the comma separated TEMPORAL_EXPERIMENTS environment variable.
+func envExperiments() ([]string, error) {
+	var names []string
+	for _, name := range strings.Split(os.Getenv("TEMPORAL_EXPERIMENTS"), ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if _, ok := experiments[name]; !ok {
+			return nil, fmt.Errorf("unknown experiment %q, expected one of: %s", name, strings.Join(experimentNames(), ", "))
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// requireExperiment returns an error if the experiment is not enabled in the provider configuration.
+func (c *TemporalClient) requireExperiment(name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !c.experiments[name] {
+		diags.AddError(summaryExperimentNotEnabled,
+			fmt.Sprintf("This is an experimental part of the provider and may change in a future release without a major version. "+
+				"Add %q to the provider's experiments attribute or the TEMPORAL_EXPERIMENTS environment variable to use %s.", name, experiments[name]))
+	}
+	return diags
+}
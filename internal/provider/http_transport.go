@@ -0,0 +1,365 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcCreds "google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Transports of the transport attribute.
+const (
+	transportGRPC = "grpc"
+	transportHTTP = "http"
+)
+
+// httpAPIPrefix is the path prefix of the frontend's HTTP API.
+const httpAPIPrefix = "/api/v1"
+
+// httpPathVariable matches the variables of HTTP rule path templates, e.g. {namespace} or {execution.workflow_id}.
+var httpPathVariable = regexp.MustCompile(`\{([^}=]+)(=[^}]*)?\}`)
+
+// temporalConn is the connection the service clients of a TemporalClient send their requests over.
+type temporalConn interface {
+	grpc.ClientConnInterface
+	Close() error
+}
+
+var _ temporalConn = &httpConn{}
+
+// httpConn sends the requests of the generated service clients to the frontend's HTTP API instead of gRPC, for
+// networks that only let HTTP/1.1 out. Methods are mapped to URLs with the google.api.http annotations of the
+// Temporal API, and messages are sent as JSON. Methods without an annotation, such as adding search attributes,
+// are not available over HTTP and fail with Unimplemented.
+type httpConn struct {
+	baseURL     string
+	client      *http.Client
+	insecure    bool
+	credentials []grpcCreds.PerRPCCredentials
+	interceptor grpc.UnaryClientInterceptor
+}
+
+// newHTTPConn creates a connection to the HTTP API at endpoint. interceptors wrap every request, in order.
+func newHTTPConn(endpoint string, insecure bool, tlsConfig *tls.Config, proxyURL *url.URL, credentials []grpcCreds.PerRPCCredentials, interceptors ...grpc.UnaryClientInterceptor) *httpConn {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig.Clone()
+	}
+	if proxyURL != nil {
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	scheme := "https"
+	if insecure {
+		scheme = "http"
+	}
+
+	return &httpConn{
+		baseURL:     scheme + "://" + endpoint + httpAPIPrefix,
+		client:      &http.Client{Transport: transport},
+		insecure:    insecure,
+		credentials: credentials,
+		interceptor: chainUnaryInterceptors(interceptors),
+	}
+}
+
+// Invoke sends a request through the interceptors. Interceptors get a nil *grpc.ClientConn.
+func (c *httpConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	return c.interceptor(ctx, method, args, reply, nil, c.invoke, opts...)
+}
+
+// NewStream is not supported, the provider only makes unary calls.
+func (c *httpConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Errorf(codes.Unimplemented, "%s is a streaming method, which the HTTP transport does not support", method)
+}
+
+// Close releases the idle connections.
+func (c *httpConn) Close() error {
+	c.client.CloseIdleConnections()
+	return nil
+}
+
+// invoke sends one request to the HTTP API.
+func (c *httpConn) invoke(ctx context.Context, method string, args, reply any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+	req, ok := args.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "request of %s is not a proto message", method)
+	}
+	resp, ok := reply.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "response of %s is not a proto message", method)
+	}
+
+	verb, template, body, err := httpRule(method)
+	if err != nil {
+		return err
+	}
+	httpReq, err := c.newRequest(ctx, req.ProtoReflect(), verb, template, body)
+	if err != nil {
+		return err
+	}
+
+	httpResp, err := c.client.Do(httpReq)
+	if err != nil {
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return status.Errorf(codes.Unavailable, "HTTP request to %s failed: %v", httpReq.URL.Host, err)
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "unable to read the HTTP response: %v", err)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return httpError(httpResp, respBody)
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(respBody, resp); err != nil {
+		return status.Errorf(codes.Internal, "unable to decode the HTTP response of %s: %v", method, err)
+	}
+	return nil
+}
+
+// newRequest builds the HTTP request of a method from its HTTP rule: path variables are taken from the message,
+// the body is the message or one of its fields, and the remaining fields of bodiless requests become query parameters.
+func (c *httpConn) newRequest(ctx context.Context, m protoreflect.Message, verb, template, body string) (*http.Request, error) {
+	var pathErr error
+	pathFields := make(map[string]bool)
+	urlPath := httpPathVariable.ReplaceAllStringFunc(template, func(variable string) string {
+		fieldPath := httpPathVariable.FindStringSubmatch(variable)[1]
+		pathFields[strings.Split(fieldPath, ".")[0]] = true
+		value, err := fieldValue(m, fieldPath)
+		if err != nil {
+			pathErr = err
+		}
+		return url.PathEscape(value)
+	})
+	if pathErr != nil {
+		return nil, pathErr
+	}
+
+	var reader io.Reader
+	query := url.Values{}
+	switch body {
+	case "*":
+		content, err := protojson.Marshal(m.Interface())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to encode the request: %v", err)
+		}
+		reader = bytes.NewReader(content)
+	case "":
+		content, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m.Interface())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to encode the request: %v", err)
+		}
+		var fields map[string]any
+		if err := json.Unmarshal(content, &fields); err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to encode the request: %v", err)
+		}
+		for name, value := range fields {
+			if !pathFields[name] {
+				addQueryParameters(query, name, value)
+			}
+		}
+	default:
+		field := m.Descriptor().Fields().ByName(protoreflect.Name(body))
+		if field == nil || field.Kind() != protoreflect.MessageKind {
+			return nil, status.Errorf(codes.Internal, "HTTP rule body %q is not a message field", body)
+		}
+		content, err := protojson.Marshal(m.Get(field).Message().Interface())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to encode the request: %v", err)
+		}
+		reader = bytes.NewReader(content)
+	}
+
+	target := c.baseURL + urlPath
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, verb, target, reader)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to create the HTTP request: %v", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Metadata set by interceptors, such as the headers attribute, is sent as HTTP headers.
+	md, _ := metadata.FromOutgoingContext(ctx)
+	for key, values := range md {
+		for _, value := range values {
+			httpReq.Header.Add(key, value)
+		}
+	}
+	for _, credentials := range c.credentials {
+		if c.insecure && credentials.RequireTransportSecurity() {
+			return nil, status.Error(codes.Unauthenticated, "the credentials require TLS and are not sent over a plaintext connection")
+		}
+		headers, err := credentials.GetRequestMetadata(ctx, c.baseURL)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "unable to get the request credentials: %v", err)
+		}
+		for key, value := range headers {
+			httpReq.Header.Set(key, value)
+		}
+	}
+
+	return httpReq, nil
+}
+
+// httpRule returns the HTTP verb, path template and body of a method. Paths under /api/v1, which the frontend
+// serves, are preferred over the others of the rule.
+func httpRule(method string) (string, string, string, error) {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(method, "/"), "/", "."))
+	descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return "", "", "", status.Errorf(codes.Unimplemented, "unknown method %s", method)
+	}
+	methodDescriptor, ok := descriptor.(protoreflect.MethodDescriptor)
+	if !ok {
+		return "", "", "", status.Errorf(codes.Unimplemented, "unknown method %s", method)
+	}
+
+	rule, _ := proto.GetExtension(methodDescriptor.Options(), annotations.E_Http).(*annotations.HttpRule)
+	if rule == nil {
+		return "", "", "", status.Errorf(codes.Unimplemented,
+			"%s is not available over the HTTP API of the frontend. Use transport = \"grpc\" to manage this object.", methodDescriptor.Name())
+	}
+
+	for _, candidate := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+		verb, template := httpPattern(candidate)
+		if strings.HasPrefix(template, httpAPIPrefix+"/") {
+			return verb, strings.TrimPrefix(template, httpAPIPrefix), candidate.GetBody(), nil
+		}
+	}
+	verb, template := httpPattern(rule)
+	return verb, template, rule.GetBody(), nil
+}
+
+// httpPattern returns the verb and path template of an HTTP rule.
+func httpPattern(rule *annotations.HttpRule) (string, string) {
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		return http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Post:
+		return http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Put:
+		return http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Delete:
+		return http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Patch:
+		return http.MethodPatch, pattern.Patch
+	default:
+		return http.MethodPost, ""
+	}
+}
+
+// fieldValue returns the string value of a dotted path of singular fields, e.g. execution.workflow_id.
+func fieldValue(m protoreflect.Message, fieldPath string) (string, error) {
+	names := strings.Split(fieldPath, ".")
+	for i, name := range names {
+		field := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if field == nil || field.IsList() || field.IsMap() {
+			return "", status.Errorf(codes.Internal, "HTTP path variable %q is not a singular field", fieldPath)
+		}
+		if i < len(names)-1 {
+			if field.Kind() != protoreflect.MessageKind {
+				return "", status.Errorf(codes.Internal, "HTTP path variable %q is not a field path", fieldPath)
+			}
+			m = m.Get(field).Message()
+			continue
+		}
+		return fmt.Sprint(m.Get(field).Interface()), nil
+	}
+	return "", nil
+}
+
+// addQueryParameters adds a JSON encoded field to the query, nested messages with dotted names.
+func addQueryParameters(query url.Values, name string, value any) {
+	switch v := value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			addQueryParameters(query, name+"."+key, v[key])
+		}
+	case []any:
+		for _, item := range v {
+			addQueryParameters(query, name, item)
+		}
+	default:
+		query.Add(name, fmt.Sprint(v))
+	}
+}
+
+// httpError converts an error response of the HTTP API back into the gRPC status the frontend returned,
+// so that resources handle errors the same way over both transports.
+func httpError(resp *http.Response, body []byte) error {
+	var st spb.Status
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, &st); err == nil && st.GetCode() != 0 {
+		return status.ErrorProto(&st)
+	}
+
+	code := codes.Unknown
+	switch resp.StatusCode {
+	case http.StatusBadRequest:
+		code = codes.InvalidArgument
+	case http.StatusUnauthorized:
+		code = codes.Unauthenticated
+	case http.StatusForbidden:
+		code = codes.PermissionDenied
+	case http.StatusNotFound:
+		code = codes.NotFound
+	case http.StatusConflict:
+		code = codes.AlreadyExists
+	case http.StatusTooManyRequests:
+		code = codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		code = codes.Unimplemented
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		code = codes.Unavailable
+	case http.StatusGatewayTimeout:
+		code = codes.DeadlineExceeded
+	}
+	return status.Errorf(code, "HTTP API returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+}
+
+// chainUnaryInterceptors combines interceptors into one, the first being the outermost, like grpc.WithChainUnaryInterceptor.
+func chainUnaryInterceptors(interceptors []grpc.UnaryClientInterceptor) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return chainedInvoker(interceptors, invoker)(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// chainedInvoker returns an invoker that calls the interceptors in order, and then invoker.
+func chainedInvoker(interceptors []grpc.UnaryClientInterceptor, invoker grpc.UnaryInvoker) grpc.UnaryInvoker {
+	if len(interceptors) == 0 {
+		return invoker
+	}
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return interceptors[0](ctx, method, req, reply, cc, chainedInvoker(interceptors[1:], invoker), opts...)
+	}
+}
@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcCreds "google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestHTTPRule(t *testing.T) {
+	tests := []struct {
+		method       string
+		wantVerb     string
+		wantTemplate string
+		wantBody     string
+		wantCode     codes.Code
+	}{
+		{method: describeNamespaceMethod, wantVerb: http.MethodGet, wantTemplate: "/namespaces/{namespace}"},
+		{method: registerNamespaceMethod, wantVerb: http.MethodPost, wantTemplate: "/namespaces", wantBody: "*"},
+		{method: startWorkflowMethod, wantVerb: http.MethodPost, wantTemplate: "/namespaces/{namespace}/workflows/{workflow_id}", wantBody: "*"},
+		{method: "/temporal.api.workflowservice.v1.WorkflowService/DeleteSchedule", wantVerb: http.MethodDelete, wantTemplate: "/namespaces/{namespace}/schedules/{schedule_id}"},
+		{method: "/temporal.api.operatorservice.v1.OperatorService/AddSearchAttributes", wantCode: codes.Unimplemented},
+		{method: "/temporal.api.workflowservice.v1.WorkflowService/Unknown", wantCode: codes.Unimplemented},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			verb, template, body, err := httpRule(tt.method)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("httpRule() error = %v, want code %s", err, tt.wantCode)
+			}
+			if verb != tt.wantVerb || template != tt.wantTemplate || body != tt.wantBody {
+				t.Errorf("httpRule() = %s %s body %q, want %s %s body %q", verb, template, body, tt.wantVerb, tt.wantTemplate, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestHTTPError(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   codes.Code
+	}{
+		{"status body", http.StatusBadRequest, `{"code":6,"message":"Namespace already exists."}`, codes.AlreadyExists},
+		{"not found", http.StatusNotFound, "not found", codes.NotFound},
+		{"rate limited", http.StatusTooManyRequests, "", codes.ResourceExhausted},
+		{"gateway down", http.StatusBadGateway, "<html>", codes.Unavailable},
+		{"gateway timeout", http.StatusGatewayTimeout, "", codes.DeadlineExceeded},
+		{"other", http.StatusTeapot, "", codes.Unknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: tt.status, Status: http.StatusText(tt.status)}
+			if got := status.Code(httpError(resp, []byte(tt.body))); got != tt.want {
+				t.Errorf("httpError(%d) code = %s, want %s", tt.status, got, tt.want)
+			}
+		})
+	}
+}
+
+// recordedRequest is what fakeHTTPAPI saw of a request.
+type recordedRequest struct {
+	method string
+	path   string
+	query  string
+	header http.Header
+	body   string
+}
+
+// fakeHTTPAPI answers every request with status and body, and records it.
+func fakeHTTPAPI(t *testing.T, code int, body string) (string, *recordedRequest) {
+	t.Helper()
+	recorded := &recordedRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		*recorded = recordedRequest{method: r.Method, path: r.URL.EscapedPath(), query: r.URL.RawQuery, header: r.Header, body: string(b)}
+		w.WriteHeader(code)
+		_, _ = io.WriteString(w, body)
+	}))
+	t.Cleanup(server.Close)
+	return strings.TrimPrefix(server.URL, "http://"), recorded
+}
+
+func TestHTTPConn(t *testing.T) {
+	t.Run("path variables and response", func(t *testing.T) {
+		endpoint, recorded := fakeHTTPAPI(t, http.StatusOK, `{"namespaceInfo":{"name":"orders/eu","id":"42"},"unknownField":true}`)
+		client := workflowservice.NewWorkflowServiceClient(newHTTPConn(endpoint, true, nil, nil, nil))
+
+		resp, err := client.DescribeNamespace(context.Background(), &workflowservice.DescribeNamespaceRequest{Namespace: "orders/eu"})
+		if err != nil {
+			t.Fatalf("DescribeNamespace() error = %v", err)
+		}
+		if recorded.method != http.MethodGet || recorded.path != "/api/v1/namespaces/orders%2Feu" {
+			t.Errorf("request = %s %s, want GET /api/v1/namespaces/orders%%2Feu", recorded.method, recorded.path)
+		}
+		if recorded.query != "" {
+			t.Errorf("query = %q, want the path field left out", recorded.query)
+		}
+		if resp.GetNamespaceInfo().GetId() != "42" {
+			t.Errorf("DescribeNamespace() = %v, want the decoded response", resp)
+		}
+	})
+
+	t.Run("query parameters", func(t *testing.T) {
+		endpoint, recorded := fakeHTTPAPI(t, http.StatusOK, `{}`)
+		client := workflowservice.NewWorkflowServiceClient(newHTTPConn(endpoint, true, nil, nil, nil))
+
+		_, err := client.ListSchedules(context.Background(), &workflowservice.ListSchedulesRequest{Namespace: "orders", MaximumPageSize: 10, NextPageToken: []byte("n")})
+		if err != nil {
+			t.Fatalf("ListSchedules() error = %v", err)
+		}
+		if recorded.path != "/api/v1/namespaces/orders/schedules" || recorded.query != "maximum_page_size=10&next_page_token=bg%3D%3D" {
+			t.Errorf("request = %s?%s", recorded.path, recorded.query)
+		}
+	})
+
+	t.Run("body", func(t *testing.T) {
+		endpoint, recorded := fakeHTTPAPI(t, http.StatusOK, `{}`)
+		client := workflowservice.NewWorkflowServiceClient(newHTTPConn(endpoint, true, nil, nil, nil))
+
+		_, err := client.UpdateNamespace(context.Background(), &workflowservice.UpdateNamespaceRequest{
+			Namespace:  "orders",
+			UpdateInfo: &namespace.UpdateNamespaceInfo{Description: "Orders"},
+		})
+		if err != nil {
+			t.Fatalf("UpdateNamespace() error = %v", err)
+		}
+		if recorded.method != http.MethodPost || recorded.path != "/api/v1/namespaces/orders/update" {
+			t.Errorf("request = %s %s", recorded.method, recorded.path)
+		}
+		if !strings.Contains(recorded.body, `"updateInfo":{"description":"Orders"}`) || recorded.header.Get("Content-Type") != "application/json" {
+			t.Errorf("body = %s, want the JSON request", recorded.body)
+		}
+	})
+
+	t.Run("headers and credentials", func(t *testing.T) {
+		endpoint, recorded := fakeHTTPAPI(t, http.StatusOK, `{}`)
+		conn := newHTTPConn(endpoint, true, nil, nil, []grpcCreds.PerRPCCredentials{authTokenCredentials("token")})
+		client := workflowservice.NewWorkflowServiceClient(conn)
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "x-team", "payments")
+		if _, err := client.GetSystemInfo(ctx, &workflowservice.GetSystemInfoRequest{}); err != nil {
+			t.Fatalf("GetSystemInfo() error = %v", err)
+		}
+		if recorded.header.Get("X-Team") != "payments" || !strings.HasSuffix(recorded.header.Get("Authorization"), "token") {
+			t.Errorf("headers = %v, want the metadata and the credentials", recorded.header)
+		}
+	})
+
+	t.Run("credentials that require TLS", func(t *testing.T) {
+		endpoint, _ := fakeHTTPAPI(t, http.StatusOK, `{}`)
+		client := workflowservice.NewWorkflowServiceClient(newHTTPConn(endpoint, true, nil, nil, []grpcCreds.PerRPCCredentials{apiKeyCredentials("key")}))
+
+		_, err := client.GetSystemInfo(context.Background(), &workflowservice.GetSystemInfoRequest{})
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("GetSystemInfo() error = %v, want Unauthenticated", err)
+		}
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		endpoint, _ := fakeHTTPAPI(t, http.StatusNotFound, `{"code":5,"message":"Namespace orders is not found."}`)
+		client := workflowservice.NewWorkflowServiceClient(newHTTPConn(endpoint, true, nil, nil, nil))
+
+		_, err := client.DescribeNamespace(context.Background(), &workflowservice.DescribeNamespaceRequest{Namespace: "orders"})
+		if st := status.Convert(err); st.Code() != codes.NotFound || st.Message() != "Namespace orders is not found." {
+			t.Errorf("DescribeNamespace() error = %v, want the status of the frontend", err)
+		}
+	})
+
+	t.Run("method without HTTP rule", func(t *testing.T) {
+		endpoint, recorded := fakeHTTPAPI(t, http.StatusOK, `{}`)
+		client := operatorservice.NewOperatorServiceClient(newHTTPConn(endpoint, true, nil, nil, nil))
+
+		_, err := client.AddSearchAttributes(context.Background(), &operatorservice.AddSearchAttributesRequest{Namespace: "orders"})
+		if status.Code(err) != codes.Unimplemented || recorded.method != "" {
+			t.Errorf("AddSearchAttributes() error = %v, want Unimplemented without a request", err)
+		}
+	})
+
+	t.Run("unreachable frontend", func(t *testing.T) {
+		endpoint, _ := fakeHTTPAPI(t, http.StatusOK, `{}`)
+		server := newHTTPConn(endpoint, true, nil, nil, nil)
+		server.baseURL = "http://127.0.0.1:1" + httpAPIPrefix
+		client := workflowservice.NewWorkflowServiceClient(server)
+
+		if _, err := client.GetSystemInfo(context.Background(), &workflowservice.GetSystemInfoRequest{}); status.Code(err) != codes.Unavailable {
+			t.Errorf("GetSystemInfo() error = %v, want Unavailable", err)
+		}
+	})
+}
+
+func TestHTTPConnInterceptors(t *testing.T) {
+	endpoint, recorded := fakeHTTPAPI(t, http.StatusOK, `{}`)
+
+	var order []string
+	interceptor := func(name string) grpc.UnaryClientInterceptor {
+		return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			order = append(order, name)
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+	}
+	conn := newHTTPConn(endpoint, true, nil, nil, nil, interceptor("outer"), identityInterceptor("terraform"), interceptor("inner"))
+	client := workflowservice.NewWorkflowServiceClient(conn)
+
+	_, err := client.StartWorkflowExecution(context.Background(), &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:    "orders",
+		WorkflowId:   "health",
+		WorkflowType: &common.WorkflowType{Name: "HealthCheck"},
+	})
+	if err != nil {
+		t.Fatalf("StartWorkflowExecution() error = %v", err)
+	}
+	if strings.Join(order, ",") != "outer,inner" {
+		t.Errorf("interceptors ran in order %v, want outer then inner", order)
+	}
+	if recorded.path != "/api/v1/namespaces/orders/workflows/health" || !strings.Contains(recorded.body, `"identity":"terraform"`) {
+		t.Errorf("request = %s %s, want the identity set by the interceptor", recorded.path, recorded.body)
+	}
+}
@@ -0,0 +1,306 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.temporal.io/api/operatorservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// requestIDNamespace is the UUID namespace request IDs are derived in.
+var requestIDNamespace = uuid.MustParse("6f1b9a52-3c0e-4a8e-9d57-2f6a1c4e7b10")
+
+const retryMaxAttempts = 5
+
+// The backoff of retryInterceptor. Variables, so tests do not wait for it.
+var (
+	retryInitialDelay = 200 * time.Millisecond
+	retryMaxDelay     = 5 * time.Second
+)
+
+// readMethodPrefixes are the prefixes of the API methods that do not change anything, and can be retried freely.
+var readMethodPrefixes = []string{"Describe", "Get", "List", "Count", "Scan"}
+
+// namespaceRedirectInterceptor retries requests rejected with NamespaceNotActive against the
+// frontend of the active cluster, when its address is known.
+func namespaceRedirectInterceptor(addresses map[string]string, dial func(endpoint string) (*grpc.ClientConn, error)) grpc.UnaryClientInterceptor {
+	var mu sync.Mutex
+	conns := make(map[string]*grpc.ClientConn)
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		notActive, ok := asNamespaceNotActive(err)
+		if !ok {
+			return err
+		}
+
+		address, ok := addresses[notActive.ActiveCluster]
+		if !ok {
+			return err
+		}
+
+		mu.Lock()
+		conn, ok := conns[address]
+		if !ok {
+			var dialErr error
+			conn, dialErr = dial(address)
+			if dialErr != nil {
+				mu.Unlock()
+				tflog.Warn(ctx, "Unable to connect to active cluster", map[string]any{"cluster": notActive.ActiveCluster, "address": address, "err": dialErr})
+				return err
+			}
+			conns[address] = conn
+		}
+		mu.Unlock()
+
+		tflog.Info(ctx, "Redirecting request to active cluster", map[string]any{"method": method, "cluster": notActive.ActiveCluster, "address": address})
+
+		return conn.Invoke(ctx, method, req, reply, opts...)
+	}
+}
+
+// activeClusterInterceptor annotates NamespaceNotActive errors with the frontend address of the active cluster,
+// looked up with ListClusters, so diagnostics can say where the request should have been sent.
+func activeClusterInterceptor() grpc.UnaryClientInterceptor {
+	var addresses sync.Map
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		notActive, ok := asNamespaceNotActive(err)
+		if !ok {
+			return err
+		}
+
+		address, ok := addresses.Load(notActive.ActiveCluster)
+		if !ok {
+			var lookupErr error
+			address, lookupErr = clusterAddress(ctx, operatorservice.NewOperatorServiceClient(cc), notActive.ActiveCluster)
+			if lookupErr != nil {
+				tflog.Debug(ctx, "Unable to look up active cluster address", map[string]any{"cluster": notActive.ActiveCluster, "err": lookupErr})
+				return err
+			}
+			addresses.Store(notActive.ActiveCluster, address)
+		}
+
+		return &namespaceNotActiveError{err: err, method: method, address: address.(string)}
+	}
+}
+
+// clusterAddress returns the frontend address of a cluster known to the connected cluster.
+func clusterAddress(ctx context.Context, client operatorservice.OperatorServiceClient, name string) (string, error) {
+	var nextPageToken []byte
+	for {
+		clusters, err := client.ListClusters(ctx, &operatorservice.ListClustersRequest{
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return "", err
+		}
+
+		for _, cluster := range clusters.GetClusters() {
+			if cluster.GetClusterName() == name {
+				return cluster.GetAddress(), nil
+			}
+		}
+
+		nextPageToken = clusters.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			return "", fmt.Errorf("cluster %q is not registered with the connected cluster", name)
+		}
+	}
+}
+
+// headersInterceptor attaches the configured metadata to every request.
+func headersInterceptor(headers map[string]string) grpc.UnaryClientInterceptor {
+	pairs := make([]string, 0, 2*len(headers))
+	for key, value := range headers {
+		pairs = append(pairs, key, value)
+	}
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(metadata.AppendToOutgoingContext(ctx, pairs...), method, req, reply, cc, opts...)
+	}
+}
+
+// cliCommandInterceptor logs the temporal CLI command equivalent to each mutating request, so operators can
+// review what the provider does and repeat it by hand.
+func cliCommandInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if command, ok := cliCommand(req); ok {
+			tflog.Info(ctx, "Equivalent temporal CLI command", map[string]any{"method": method, "command": command})
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// timingsInterceptor records the wall time of each request, including redirects, in the operationTimings
+// of the resource operation that made it.
+func timingsInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		if timings, ok := ctx.Value(operationTimingsKey{}).(*operationTimings); ok {
+			timings.record(method, time.Since(start))
+		}
+		return err
+	}
+}
+
+// retryInterceptor retries requests that failed with a transient error, with exponential backoff.
+// Reads are retried on any transient error. Mutations are only retried when the error guarantees that they were
+// not applied, or when they carry a request ID that lets the server deduplicate them, so a retry never creates
+// a schedule twice or sends a signal twice.
+func retryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		delay := retryInitialDelay
+		for attempt := 1; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || attempt == retryMaxAttempts || !retryable(method, req, status.Code(err)) {
+				return err
+			}
+
+			tflog.Debug(ctx, "Retrying request", map[string]any{"method": method, "attempt": attempt, "err": err})
+
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return err
+			}
+			delay = min(2*delay, retryMaxDelay)
+		}
+	}
+}
+
+// retryable reports whether a request that failed with code can be sent again.
+func retryable(method string, req interface{}, code codes.Code) bool {
+	switch code {
+	case codes.ResourceExhausted:
+		// The request was rejected by rate limiting before it was processed.
+		return true
+	case codes.Unavailable, codes.Aborted:
+		// The request may or may not have been applied.
+		return isReadMethod(method) || hasRequestID(req)
+	case codes.DeadlineExceeded, codes.Internal:
+		return isReadMethod(method)
+	default:
+		return false
+	}
+}
+
+func isReadMethod(method string) bool {
+	name := path.Base(method)
+	for _, prefix := range readMethodPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasRequestID reports whether the request carries a request ID, as set by requestIDInterceptor.
+func hasRequestID(req interface{}) bool {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return false
+	}
+	m := msg.ProtoReflect()
+	field := m.Descriptor().Fields().ByName("request_id")
+	return field != nil && field.Kind() == protoreflect.StringKind && !field.IsList() && m.Get(field).String() != ""
+}
+
+// resourceOperationKey is the context key of the resourceOperation a request is made for.
+type resourceOperationKey struct{}
+
+// resourceOperation is the resource operation a request is made for, as set by timeOperation.
+type resourceOperation struct {
+	operation string
+	address   string
+}
+
+// withResourceOperation records the resource operation the requests made with ctx belong to.
+func withResourceOperation(ctx context.Context, operation, address string) context.Context {
+	return context.WithValue(ctx, resourceOperationKey{}, resourceOperation{operation: operation, address: address})
+}
+
+// requestIDMethods are the methods that get a request ID. Each creates something that a retry must not create twice.
+// RegisterNamespace would belong here too, but its request has no request_id field.
+var requestIDMethods = map[string]bool{
+	"CreateSchedule":         true,
+	"StartWorkflowExecution": true,
+}
+
+// requestIDInterceptor fills an empty request_id field of the requests in requestIDMethods with an ID derived
+// from the resource address and the operation they are made for. The ID is the same in every apply, so when an
+// apply that failed part way is run again, the server deduplicates the request instead of, for example, starting
+// a workflow twice. Requests made outside a resource operation are left alone. run only correlates the log
+// entries of one provider process; it is not part of the ID.
+func requestIDInterceptor(run string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		operation, ok := ctx.Value(resourceOperationKey{}).(resourceOperation)
+		if msg, isMsg := req.(proto.Message); ok && isMsg {
+			if id, ok := setRequestID(method, msg, operation); ok {
+				ctx = tflog.SetField(ctx, "request_id", id)
+				tflog.Debug(ctx, "Attached request ID", map[string]any{
+					"method":    method,
+					"resource":  operation.address,
+					"operation": operation.operation,
+					"run":       run,
+				})
+			}
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// identityInterceptor fills an empty identity field with the provider's client identity, so the server records
+// which Terraform run made a change.
+func identityInterceptor(identity string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if msg, ok := req.(proto.Message); ok {
+			m := msg.ProtoReflect()
+			field := m.Descriptor().Fields().ByName("identity")
+			if field != nil && field.Kind() == protoreflect.StringKind && !field.IsList() && m.Get(field).String() == "" {
+				m.Set(field, protoreflect.ValueOfString(identity))
+			}
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// setRequestID sets the request_id field of msg if method is one of requestIDMethods and the field is empty,
+// returning the ID it set.
+func setRequestID(method string, msg proto.Message, operation resourceOperation) (string, bool) {
+	if !requestIDMethods[path.Base(method)] {
+		return "", false
+	}
+
+	m := msg.ProtoReflect()
+	field := m.Descriptor().Fields().ByName("request_id")
+	if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() || m.Get(field).String() != "" {
+		return "", false
+	}
+
+	seed := strings.Join([]string{operation.address, operation.operation, path.Base(method)}, "\x00")
+	id := uuid.NewSHA1(requestIDNamespace, []byte(seed)).String()
+	m.Set(field, protoreflect.ValueOfString(id))
+
+	return id, true
+}
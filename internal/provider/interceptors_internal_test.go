@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	createScheduleMethod    = "/temporal.api.workflowservice.v1.WorkflowService/CreateSchedule"
+	registerNamespaceMethod = "/temporal.api.workflowservice.v1.WorkflowService/RegisterNamespace"
+	startWorkflowMethod     = "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"
+	signalWorkflowMethod    = "/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution"
+	describeNamespaceMethod = "/temporal.api.workflowservice.v1.WorkflowService/DescribeNamespace"
+	updateNamespaceMethod   = "/temporal.api.workflowservice.v1.WorkflowService/UpdateNamespace"
+)
+
+func TestSetRequestID(t *testing.T) {
+	create := resourceOperation{operation: "create", address: "temporal_namespace.orders"}
+	first, ok := setRequestID(createScheduleMethod, &workflowservice.CreateScheduleRequest{Namespace: "orders", ScheduleId: "health"}, create)
+	if !ok {
+		t.Fatal("expected a request ID for CreateSchedule")
+	}
+
+	tests := []struct {
+		name      string
+		method    string
+		msg       proto.Message
+		operation resourceOperation
+		wantSet   bool
+		wantSame  bool
+	}{
+		{
+			name:      "retry reuses the ID",
+			method:    createScheduleMethod,
+			msg:       &workflowservice.CreateScheduleRequest{Namespace: "orders", ScheduleId: "health"},
+			operation: create,
+			wantSet:   true,
+			wantSame:  true,
+		},
+		{
+			name:      "request content does not change the ID",
+			method:    createScheduleMethod,
+			msg:       &workflowservice.CreateScheduleRequest{Namespace: "orders", ScheduleId: "changed"},
+			operation: create,
+			wantSet:   true,
+			wantSame:  true,
+		},
+		{
+			name:      "a request made again in a later apply reuses the ID",
+			method:    createScheduleMethod,
+			msg:       &workflowservice.CreateScheduleRequest{Namespace: "orders", ScheduleId: "health"},
+			operation: resourceOperation{operation: "create", address: "temporal_namespace.orders"},
+			wantSet:   true,
+			wantSame:  true,
+		},
+		{
+			name:      "another resource gets a new ID",
+			method:    createScheduleMethod,
+			msg:       &workflowservice.CreateScheduleRequest{Namespace: "orders", ScheduleId: "health"},
+			operation: resourceOperation{operation: "create", address: "temporal_namespace.payments"},
+			wantSet:   true,
+		},
+		{
+			name:      "another operation gets a new ID",
+			method:    createScheduleMethod,
+			msg:       &workflowservice.CreateScheduleRequest{Namespace: "orders", ScheduleId: "health"},
+			operation: resourceOperation{operation: "update", address: "temporal_namespace.orders"},
+			wantSet:   true,
+		},
+		{
+			name:      "another method gets a new ID",
+			method:    startWorkflowMethod,
+			msg:       &workflowservice.StartWorkflowExecutionRequest{Namespace: "orders"},
+			operation: create,
+			wantSet:   true,
+		},
+		{
+			name:      "other methods with a request_id field are left alone",
+			method:    signalWorkflowMethod,
+			msg:       &workflowservice.SignalWorkflowExecutionRequest{Namespace: "orders"},
+			operation: create,
+		},
+		{
+			name:      "a request ID set by the caller is kept",
+			method:    startWorkflowMethod,
+			msg:       &workflowservice.StartWorkflowExecutionRequest{Namespace: "orders", RequestId: "caller"},
+			operation: create,
+		},
+		{
+			name:      "messages without a request_id field are left alone",
+			method:    registerNamespaceMethod,
+			msg:       &workflowservice.RegisterNamespaceRequest{Namespace: "orders"},
+			operation: create,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := setRequestID(tt.method, tt.msg, tt.operation)
+			if ok != tt.wantSet {
+				t.Fatalf("setRequestID() set = %t, want %t", ok, tt.wantSet)
+			}
+			if !ok {
+				return
+			}
+			if got := hasRequestID(tt.msg); !got {
+				t.Errorf("hasRequestID() = false after setRequestID() set %q", id)
+			}
+			if (id == first) != tt.wantSame {
+				t.Errorf("setRequestID() = %q, first ID %q, want same = %t", id, first, tt.wantSame)
+			}
+		})
+	}
+}
+
+func TestHasRequestID(t *testing.T) {
+	tests := []struct {
+		name string
+		req  interface{}
+		want bool
+	}{
+		{"set", &workflowservice.StartWorkflowExecutionRequest{RequestId: "id"}, true},
+		{"empty", &workflowservice.StartWorkflowExecutionRequest{}, false},
+		{"no request_id field", &workflowservice.DescribeNamespaceRequest{Namespace: "orders"}, false},
+		{"not a proto message", "request", false},
+		{"nil", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasRequestID(tt.req); got != tt.want {
+				t.Errorf("hasRequestID() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	withID := &workflowservice.StartWorkflowExecutionRequest{RequestId: "id"}
+	withoutID := &workflowservice.UpdateNamespaceRequest{Namespace: "orders"}
+	read := &workflowservice.DescribeNamespaceRequest{Namespace: "orders"}
+
+	tests := []struct {
+		name   string
+		method string
+		req    interface{}
+		code   codes.Code
+		want   bool
+	}{
+		{"rate limited write", updateNamespaceMethod, withoutID, codes.ResourceExhausted, true},
+		{"unavailable read", describeNamespaceMethod, read, codes.Unavailable, true},
+		{"unavailable write with request ID", startWorkflowMethod, withID, codes.Unavailable, true},
+		{"unavailable write without request ID", updateNamespaceMethod, withoutID, codes.Unavailable, false},
+		{"aborted write with request ID", startWorkflowMethod, withID, codes.Aborted, true},
+		{"aborted write without request ID", updateNamespaceMethod, withoutID, codes.Aborted, false},
+		{"deadline exceeded read", describeNamespaceMethod, read, codes.DeadlineExceeded, true},
+		{"deadline exceeded write with request ID", startWorkflowMethod, withID, codes.DeadlineExceeded, false},
+		{"internal read", describeNamespaceMethod, read, codes.Internal, true},
+		{"internal write with request ID", startWorkflowMethod, withID, codes.Internal, false},
+		{"not found read", describeNamespaceMethod, read, codes.NotFound, false},
+		{"invalid argument write", updateNamespaceMethod, withoutID, codes.InvalidArgument, false},
+		{"canceled read", describeNamespaceMethod, read, codes.Canceled, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryable(tt.method, tt.req, tt.code); got != tt.want {
+				t.Errorf("retryable(%s, %s) = %t, want %t", tt.method, tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsReadMethod(t *testing.T) {
+	tests := []struct {
+		method string
+		want   bool
+	}{
+		{describeNamespaceMethod, true},
+		{"/temporal.api.workflowservice.v1.WorkflowService/GetSystemInfo", true},
+		{"/temporal.api.workflowservice.v1.WorkflowService/ListSchedules", true},
+		{"/temporal.api.workflowservice.v1.WorkflowService/CountWorkflowExecutions", true},
+		{"/temporal.api.workflowservice.v1.WorkflowService/ScanWorkflowExecutions", true},
+		{updateNamespaceMethod, false},
+		{startWorkflowMethod, false},
+		{"/temporal.api.operatorservice.v1.OperatorService/DeleteNamespace", false},
+		// The prefix is matched on the method name, not the service.
+		{"/temporal.api.workflowservice.v1.GetService/UpdateSchedule", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			if got := isReadMethod(tt.method); got != tt.want {
+				t.Errorf("isReadMethod(%s) = %t, want %t", tt.method, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryInterceptor(t *testing.T) {
+	initialDelay, maxDelay := retryInitialDelay, retryMaxDelay
+	retryInitialDelay, retryMaxDelay = time.Millisecond, time.Millisecond
+	t.Cleanup(func() { retryInitialDelay, retryMaxDelay = initialDelay, maxDelay })
+
+	withID := &workflowservice.StartWorkflowExecutionRequest{RequestId: "id"}
+	read := &workflowservice.DescribeNamespaceRequest{Namespace: "orders"}
+
+	tests := []struct {
+		name      string
+		method    string
+		req       interface{}
+		errs      []codes.Code
+		wantCalls int
+		wantCode  codes.Code
+	}{
+		{"success", describeNamespaceMethod, read, nil, 1, codes.OK},
+		{"read recovers", describeNamespaceMethod, read, []codes.Code{codes.Unavailable, codes.DeadlineExceeded}, 3, codes.OK},
+		{"read gives up", describeNamespaceMethod, read, []codes.Code{codes.Unavailable, codes.Unavailable, codes.Unavailable, codes.Unavailable, codes.Unavailable, codes.Unavailable}, retryMaxAttempts, codes.Unavailable},
+		{"read not found", describeNamespaceMethod, read, []codes.Code{codes.NotFound}, 1, codes.NotFound},
+		{"write with request ID on unavailable", startWorkflowMethod, withID, []codes.Code{codes.Unavailable}, 2, codes.OK},
+		{"write with request ID on deadline exceeded", startWorkflowMethod, withID, []codes.Code{codes.DeadlineExceeded}, 1, codes.DeadlineExceeded},
+		{"write without request ID on unavailable", updateNamespaceMethod, &workflowservice.UpdateNamespaceRequest{}, []codes.Code{codes.Unavailable}, 1, codes.Unavailable},
+		{"write rate limited", updateNamespaceMethod, &workflowservice.UpdateNamespaceRequest{}, []codes.Code{codes.ResourceExhausted}, 2, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			invoker := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+				calls++
+				if calls <= len(tt.errs) {
+					return status.Error(tt.errs[calls-1], "failed")
+				}
+				return nil
+			}
+
+			err := retryInterceptor()(context.Background(), tt.method, tt.req, nil, nil, invoker)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("retryInterceptor() code = %s, want %s", got, tt.wantCode)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("retryInterceptor() made %d calls, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+
+	t.Run("canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		invoker := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+			calls++
+			cancel()
+			return status.Error(codes.Unavailable, "failed")
+		}
+		retryInitialDelay = time.Hour
+		if err := retryInterceptor()(ctx, describeNamespaceMethod, read, nil, nil, invoker); status.Code(err) != codes.Unavailable || calls != 1 {
+			t.Errorf("retryInterceptor() = %v after %d calls, want the first error", err, calls)
+		}
+	})
+}
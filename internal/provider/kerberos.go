@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jcmturner/gokrb5/v8/client"
+	"github.com/jcmturner/gokrb5/v8/config"
+	"github.com/jcmturner/gokrb5/v8/credentials"
+	"github.com/jcmturner/gokrb5/v8/keytab"
+	"github.com/jcmturner/gokrb5/v8/spnego"
+	grpcCreds "google.golang.org/grpc/credentials"
+)
+
+// kerberosModel maps the kerberos block of the provider configuration.
+type kerberosModel struct {
+	ServicePrincipal types.String `tfsdk:"service_principal"`
+	Krb5Conf         types.String `tfsdk:"krb5_conf"`
+	CCache           types.String `tfsdk:"ccache"`
+	Keytab           types.String `tfsdk:"keytab"`
+	Username         types.String `tfsdk:"username"`
+	Realm            types.String `tfsdk:"realm"`
+}
+
+var _ grpcCreds.PerRPCCredentials = &spnegoCredentials{}
+
+// spnegoCredentials attaches a SPNEGO "Negotiate" token for the gateway's service principal to every RPC.
+type spnegoCredentials struct {
+	client *client.Client
+	spn    string
+}
+
+// newKerberosCredentials logs in with a keytab when one is configured, or with the credential cache otherwise.
+// Paths default to the locations used by the MIT Kerberos tools.
+func newKerberosCredentials(m kerberosModel) (*spnegoCredentials, error) {
+	confPath := m.Krb5Conf.ValueString()
+	if confPath == "" {
+		confPath = os.Getenv("KRB5_CONFIG")
+	}
+	if confPath == "" {
+		confPath = "/etc/krb5.conf"
+	}
+
+	krb5conf, err := config.Load(confPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load Kerberos configuration %s: %w", confPath, err)
+	}
+
+	var cl *client.Client
+	if !m.Keytab.IsNull() {
+		kt, err := keytab.Load(m.Keytab.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("unable to load keytab: %w", err)
+		}
+		cl = client.NewWithKeytab(m.Username.ValueString(), m.Realm.ValueString(), kt, krb5conf, client.DisablePAFXFAST(true))
+	} else {
+		ccachePath := m.CCache.ValueString()
+		if ccachePath == "" {
+			ccachePath = strings.TrimPrefix(os.Getenv("KRB5CCNAME"), "FILE:")
+		}
+		if ccachePath == "" {
+			ccachePath = fmt.Sprintf("/tmp/krb5cc_%d", os.Getuid())
+		}
+
+		ccache, err := credentials.LoadCCache(ccachePath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load credential cache %s: %w", ccachePath, err)
+		}
+		cl, err = client.NewFromCCache(ccache, krb5conf, client.DisablePAFXFAST(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to use credential cache %s: %w", ccachePath, err)
+		}
+	}
+
+	return &spnegoCredentials{client: cl, spn: m.ServicePrincipal.ValueString()}, nil
+}
+
+// GetRequestMetadata obtains a service ticket, reusing cached tickets, and returns it as a Negotiate token.
+func (c *spnegoCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	s := spnego.SPNEGOClient(c.client, c.spn)
+	if err := s.AcquireCred(); err != nil {
+		return nil, fmt.Errorf("could not acquire Kerberos credential: %w", err)
+	}
+
+	token, err := s.InitSecContext()
+	if err != nil {
+		return nil, fmt.Errorf("could not initialize SPNEGO context for %s: %w", c.spn, err)
+	}
+
+	b, err := token.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal SPNEGO token: %w", err)
+	}
+
+	return map[string]string{"authorization": "Negotiate " + base64.StdEncoding.EncodeToString(b)}, nil
+}
+
+// RequireTransportSecurity prevents service tickets from being sent over plaintext connections.
+func (c *spnegoCredentials) RequireTransportSecurity() bool {
+	return true
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/resolver"
+)
+
+// Load balancing policies of the load_balancing_policy attribute.
+const (
+	loadBalancingPickFirst  = "pick_first"
+	loadBalancingRoundRobin = "round_robin"
+)
+
+// dnsReresolveInterval is how often the frontend address is resolved again with round_robin. The gRPC DNS
+// resolver ignores requests more frequent than every 30 seconds.
+const dnsReresolveInterval = 30 * time.Second
+
+// loadBalancingDialOptions returns the dial options of a load balancing policy, with dns resolving the frontend
+// names. With round_robin, requests are spread over every address the frontend name resolves to, and the name is
+// resolved again periodically, so frontends added behind a headless Kubernetes service are picked up during long applies.
+func loadBalancingDialOptions(policy string, dns resolver.Builder) ([]grpc.DialOption, error) {
+	switch policy {
+	case "", loadBalancingPickFirst:
+		return []grpc.DialOption{grpc.WithResolvers(dns)}, nil
+	case loadBalancingRoundRobin:
+		return []grpc.DialOption{
+			grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"loadBalancingConfig": [{"%s": {}}]}`, loadBalancingRoundRobin)),
+			grpc.WithResolvers(&reresolvingBuilder{Builder: dns, interval: dnsReresolveInterval}),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown load balancing policy %q, expected %s or %s", policy, loadBalancingPickFirst, loadBalancingRoundRobin)
+	}
+}
+
+// reresolvingBuilder wraps the gRPC DNS resolver, which only resolves the name again after a connection fails,
+// so that it also does so at a fixed interval.
+type reresolvingBuilder struct {
+	resolver.Builder
+	interval time.Duration
+}
+
+// Build starts the wrapped resolver and the ticker that asks it to resolve the name again.
+func (b *reresolvingBuilder) Build(target resolver.Target, cc resolver.ClientConn, opts resolver.BuildOptions) (resolver.Resolver, error) {
+	r, err := b.Builder.Build(target, cc, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	rr := &reresolvingResolver{Resolver: r, done: make(chan struct{})}
+	go func() {
+		ticker := time.NewTicker(b.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				r.ResolveNow(resolver.ResolveNowOptions{})
+			case <-rr.done:
+				return
+			}
+		}
+	}()
+	return rr, nil
+}
+
+// reresolvingResolver stops the ticker of reresolvingBuilder when the connection is closed.
+type reresolvingResolver struct {
+	resolver.Resolver
+	done chan struct{}
+	once sync.Once
+}
+
+// Close stops the ticker and the wrapped resolver.
+func (r *reresolvingResolver) Close() {
+	r.once.Do(func() { close(r.done) })
+	r.Resolver.Close()
+}
@@ -4,13 +4,20 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.temporal.io/api/operatorservice/v1"
 	"go.temporal.io/api/workflowservice/v1"
-	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ganievs/terraform-provider-temporal/internal/convert"
+	"github.com/ganievs/terraform-provider-temporal/models"
 )
 
 // Ensures that NamespaceDataSource fully satisfies the datasource.DataSource and
@@ -27,22 +34,9 @@ func NewNamespaceDataSource() datasource.DataSource {
 
 // NamespaceDataSource implements the Terraform data source interface for Temporal namespaces.
 type NamespaceDataSource struct {
-	client workflowservice.WorkflowServiceClient
-}
-
-// NamespaceDataSourceModel defines the structure for the data source's configuration and read data.
-type NamespaceDataSourceModel struct {
-	Name                    types.String `tfsdk:"name"`
-	Id                      types.String `tfsdk:"id"`
-	Description             types.String `tfsdk:"description"`
-	OwnerEmail              types.String `tfsdk:"owner_email"`
-	Retention               types.Int64  `tfsdk:"retention"`
-	ActiveClusterName       types.String `tfsdk:"active_cluster_name"`
-	HistoryArchivalState    types.String `tfsdk:"history_archival_state"`
-	HistoryArchivalUri      types.String `tfsdk:"history_archival_uri"`
-	VisibilityArchivalState types.String `tfsdk:"visibility_archival_state"`
-	VisibilityArchivalUri   types.String `tfsdk:"visibility_archival_uri"`
-	IsGlobalNamespace       types.Bool   `tfsdk:"is_global_namespace"`
+	client         workflowservice.WorkflowServiceClient
+	operatorClient operatorservice.OperatorServiceClient
+	connection     *TemporalClient
 }
 
 // Metadata sets the metadata for the Temporal namespace data source, specifically the type name.
@@ -103,6 +97,73 @@ func (d *NamespaceDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				MarkdownDescription: "Namespace is Global",
 				Computed:            true,
 			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Namespace lifecycle state",
+				Computed:            true,
+			},
+			"data": schema.MapAttribute{
+				MarkdownDescription: "Custom key-value data attached to the namespace. Hidden in plan output, as it includes the values the namespace resource sets with `sensitive_data`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"failover_version": schema.Int64Attribute{
+				MarkdownDescription: "Current failover version of the namespace",
+				Computed:            true,
+			},
+			"failover_version_increment": schema.Int64Attribute{
+				MarkdownDescription: "The `clusterMetadata.failoverVersionIncrement` of the server configuration. It is not exposed by the API, so `next_failover_version` is only computed when it is set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"raw_json": schema.StringAttribute{
+				MarkdownDescription: "The full `DescribeNamespace` response in the proto JSON mapping, with camelCase field names and the values of `namespaceInfo.data` redacted. Gives access to fields the data source does not model yet, e.g. `jsondecode(data.temporal_namespace.example.raw_json).namespaceInfo.capabilities`.",
+				Computed:            true,
+			},
+			"include_stats": schema.BoolAttribute{
+				MarkdownDescription: "Read the counts in `stats`, which takes three more requests per namespace",
+				Optional:            true,
+			},
+			"stats": schema.SingleNestedAttribute{
+				MarkdownDescription: "Counts of objects in the namespace, for inventory reports. Only set when `include_stats` is true.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"schedules": schema.Int64Attribute{
+						MarkdownDescription: "Number of schedules",
+						Computed:            true,
+					},
+					"custom_search_attributes": schema.Int64Attribute{
+						MarkdownDescription: "Number of custom search attributes",
+						Computed:            true,
+					},
+					"running_workflows": schema.Int64Attribute{
+						MarkdownDescription: "Number of running workflow executions, as counted by the visibility store",
+						Computed:            true,
+					},
+				},
+			},
+			"clusters": schema.ListNestedAttribute{
+				MarkdownDescription: "Clusters the namespace is replicated to",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cluster_name": schema.StringAttribute{
+							MarkdownDescription: "Cluster name",
+							Computed:            true,
+						},
+						"initial_failover_version": schema.Int64Attribute{
+							MarkdownDescription: "Initial failover version of the cluster, as returned by the Operator Service",
+							Computed:            true,
+						},
+						"next_failover_version": schema.Int64Attribute{
+							MarkdownDescription: "Failover version the namespace will have after a failover to the cluster. Requires `failover_version_increment`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -116,18 +177,19 @@ func (d *NamespaceDataSource) Configure(ctx context.Context, req datasource.Conf
 		return
 	}
 
-	connection, ok := req.ProviderData.(grpc.ClientConnInterface)
+	connection, ok := req.ProviderData.(*TemporalClient)
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected grpc.ClientConnInterface), got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			summaryDataSourceConfType,
+			fmt.Sprintf("Expected *provider.TemporalClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	client := workflowservice.NewWorkflowServiceClient(connection)
-	d.client = client
+	d.client = connection.workflowService
+	d.operatorClient = connection.operatorService
+	d.connection = connection
 
 	tflog.Info(ctx, "Configured Temporal Namespace client", map[string]any{"success": true})
 }
@@ -138,31 +200,102 @@ func (d *NamespaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	var name string
 	diags := req.Config.GetAttribute(ctx, path.Root("name"), &name)
+	resp.Diagnostics.Append(diags...)
 
+	var increment types.Int64
+	diags = req.Config.GetAttribute(ctx, path.Root("failover_version_increment"), &increment)
 	resp.Diagnostics.Append(diags...)
+
+	var includeStats types.Bool
+	diags = req.Config.GetAttribute(ctx, path.Root("include_stats"), &includeStats)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	ns, err := d.client.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
 		Namespace: name,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Namespace, got error: %s", err))
+		resp.Diagnostics.AddError(summaryClientError, fmt.Sprintf("Unable to read Namespace, got error: %s", err))
 		return
 	}
 
 	tflog.Trace(ctx, "read a data source")
 
-	data := &NamespaceDataSourceModel{
-		Name:                    types.StringValue(ns.NamespaceInfo.GetName()),
-		Id:                      types.StringValue(ns.NamespaceInfo.GetId()),
-		Description:             types.StringValue(ns.NamespaceInfo.GetDescription()),
-		OwnerEmail:              types.StringValue(ns.NamespaceInfo.GetOwnerEmail()),
-		Retention:               types.Int64Value(int64(ns.Config.WorkflowExecutionRetentionTtl.AsDuration().Hours() / 24)),
-		ActiveClusterName:       types.StringValue(ns.GetReplicationConfig().GetActiveClusterName()),
-		HistoryArchivalState:    types.StringValue(ns.Config.GetHistoryArchivalState().String()),
-		HistoryArchivalUri:      types.StringValue(ns.Config.GetHistoryArchivalUri()),
-		VisibilityArchivalState: types.StringValue(ns.Config.GetVisibilityArchivalState().String()),
-		VisibilityArchivalUri:   types.StringValue(ns.Config.GetVisibilityArchivalUri()),
-		IsGlobalNamespace:       types.BoolValue(ns.GetIsGlobalNamespace()),
+	data := &models.NamespaceDataSourceModel{
+		Name:                     types.StringValue(ns.NamespaceInfo.GetName()),
+		Id:                       types.StringValue(ns.NamespaceInfo.GetId()),
+		Description:              types.StringValue(ns.NamespaceInfo.GetDescription()),
+		OwnerEmail:               types.StringValue(ns.NamespaceInfo.GetOwnerEmail()),
+		Retention:                convert.Days(ns.GetConfig().GetWorkflowExecutionRetentionTtl()),
+		ActiveClusterName:        types.StringValue(ns.GetReplicationConfig().GetActiveClusterName()),
+		HistoryArchivalState:     convert.Enum(ns.GetConfig().GetHistoryArchivalState()),
+		HistoryArchivalUri:       types.StringValue(ns.Config.GetHistoryArchivalUri()),
+		VisibilityArchivalState:  convert.Enum(ns.GetConfig().GetVisibilityArchivalState()),
+		VisibilityArchivalUri:    types.StringValue(ns.Config.GetVisibilityArchivalUri()),
+		IsGlobalNamespace:        types.BoolValue(ns.GetIsGlobalNamespace()),
+		State:                    convert.Enum(ns.GetNamespaceInfo().GetState()),
+		FailoverVersion:          types.Int64Value(ns.GetFailoverVersion()),
+		FailoverVersionIncrement: increment,
+		IncludeStats:             includeStats,
+	}
+
+	// Namespace data may hold the values of sensitive_data, so it is redacted like in logged payloads.
+	redactedNamespace := proto.Clone(ns)
+	redact(redactedNamespace.ProtoReflect())
+	data.RawJSON, err = convert.JSON(redactedNamespace)
+	if err != nil {
+		resp.Diagnostics.AddError(summaryClientError, fmt.Sprintf("Unable to serialize the namespace, got error: %s", err))
+		return
+	}
+
+	if includeStats.ValueBool() {
+		resp.Diagnostics.Append(d.connection.requireCapability(ctx, capabilitySchedules, path.Root("include_stats"))...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		stats, err := d.stats(ctx, name, &resp.Diagnostics)
+		if err != nil {
+			resp.Diagnostics.AddError(summaryClientError, fmt.Sprintf("Unable to read the namespace stats, got error: %s", requestErrorDetail(err)))
+			return
+		}
+		data.Stats = stats
+	}
+
+	initialVersions, err := d.initialFailoverVersions(ctx)
+	if err != nil {
+		// The WorkflowService answered above, so the Operator Service is most likely not routed to this endpoint.
+		if !isServiceUnreachable(err) {
+			resp.Diagnostics.AddError(summaryClientError, fmt.Sprintf("Unable to list clusters, got error: %s", requestErrorDetail(err)))
+			return
+		}
+		resp.Diagnostics.AddWarning(summaryOperatorServiceUnavailable,
+			fmt.Sprintf("Unable to list clusters, so cluster failover versions are left empty: %s", requestErrorDetail(err)))
+	}
+
+	data.Clusters = []models.NamespaceReplicationClusterModel{}
+	for _, name := range convert.ClusterNames(ns.GetReplicationConfig().GetClusters()) {
+		model := models.NamespaceReplicationClusterModel{
+			ClusterName:            types.StringValue(name),
+			InitialFailoverVersion: types.Int64Null(),
+			NextFailoverVersion:    types.Int64Null(),
+		}
+		if initial, ok := initialVersions[name]; ok {
+			model.InitialFailoverVersion = types.Int64Value(initial)
+			if !increment.IsNull() {
+				model.NextFailoverVersion = types.Int64Value(nextFailoverVersion(ns.GetFailoverVersion(), initial, increment.ValueInt64()))
+			}
+		}
+		data.Clusters = append(data.Clusters, model)
+	}
+
+	nsData, diags := types.MapValueFrom(ctx, types.StringType, ns.NamespaceInfo.GetData())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	data.Data = nsData
 
 	// Save data into Terraform state
 	diags = resp.State.Set(ctx, &data)
@@ -171,3 +304,85 @@ func (d *NamespaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 }
+
+// initialFailoverVersions returns the initial failover version of every cluster known to the connected cluster.
+func (d *NamespaceDataSource) initialFailoverVersions(ctx context.Context) (map[string]int64, error) {
+	versions := make(map[string]int64)
+
+	var nextPageToken []byte
+	for {
+		clusters, err := d.operatorClient.ListClusters(ctx, &operatorservice.ListClustersRequest{
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cluster := range clusters.GetClusters() {
+			versions[cluster.GetClusterName()] = cluster.GetInitialFailoverVersion()
+		}
+
+		nextPageToken = clusters.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			return versions, nil
+		}
+	}
+}
+
+// stats counts the schedules, custom search attributes and running workflows of the namespace. Each page of
+// schedules is retried on its own by the retry interceptor; a page that still fails keeps the count of the pages
+// before it, with a warning added to diags.
+func (d *NamespaceDataSource) stats(ctx context.Context, namespace string, diags *diag.Diagnostics) (*models.NamespaceStatsModel, error) {
+	var schedules int64
+	var nextPageToken []byte
+	for pages := 0; ; pages++ {
+		page, err := d.client.ListSchedules(ctx, &workflowservice.ListSchedulesRequest{
+			Namespace:       namespace,
+			MaximumPageSize: 1000,
+			NextPageToken:   nextPageToken,
+		})
+		if err != nil && pages > 0 && isTransient(err) {
+			diags.AddAttributeWarning(path.Root("stats").AtName("schedules"), summaryPartialList,
+				fmt.Sprintf("Counted %d schedules in %d pages, then reading the next page failed, so the count is a lower bound: %s",
+					schedules, pages, requestErrorDetail(err)))
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		schedules += int64(len(page.GetSchedules()))
+		nextPageToken = page.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	searchAttributes, err := d.operatorClient.ListSearchAttributes(ctx, &operatorservice.ListSearchAttributesRequest{Namespace: namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	running, err := d.client.CountWorkflowExecutions(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: namespace,
+		Query:     "ExecutionStatus = 'Running'",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.NamespaceStatsModel{
+		Schedules:              types.Int64Value(schedules),
+		CustomSearchAttributes: types.Int64Value(int64(len(searchAttributes.GetCustomAttributes()))),
+		RunningWorkflows:       types.Int64Value(running.GetCount()),
+	}, nil
+}
+
+// nextFailoverVersion mirrors how the server picks the failover version of a namespace failing over to a cluster:
+// the smallest version above the current one that belongs to the cluster.
+func nextFailoverVersion(current, initial, increment int64) int64 {
+	next := current - current%increment + initial
+	if next < current {
+		next += increment
+	}
+	return next
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/schedule/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pagedScheduleClient answers ListSchedules from pages, then fails with err once they run out.
+type pagedScheduleClient struct {
+	workflowservice.WorkflowServiceClient
+	pages [][]*schedule.ScheduleListEntry
+	err   error
+}
+
+func (c *pagedScheduleClient) ListSchedules(_ context.Context, req *workflowservice.ListSchedulesRequest, _ ...grpc.CallOption) (*workflowservice.ListSchedulesResponse, error) {
+	page := len(req.GetNextPageToken())
+	if page >= len(c.pages) {
+		return nil, c.err
+	}
+	resp := &workflowservice.ListSchedulesResponse{Schedules: c.pages[page]}
+	if page+1 < len(c.pages) || c.err != nil {
+		// The token is as long as the index of the next page.
+		resp.NextPageToken = make([]byte, page+1)
+	}
+	return resp, nil
+}
+
+func (c *pagedScheduleClient) CountWorkflowExecutions(context.Context, *workflowservice.CountWorkflowExecutionsRequest, ...grpc.CallOption) (*workflowservice.CountWorkflowExecutionsResponse, error) {
+	return &workflowservice.CountWorkflowExecutionsResponse{Count: 3}, nil
+}
+
+// searchAttributeCountClient lists no custom search attributes.
+type searchAttributeCountClient struct {
+	operatorservice.OperatorServiceClient
+}
+
+func (searchAttributeCountClient) ListSearchAttributes(context.Context, *operatorservice.ListSearchAttributesRequest, ...grpc.CallOption) (*operatorservice.ListSearchAttributesResponse, error) {
+	return &operatorservice.ListSearchAttributesResponse{}, nil
+}
+
+func TestNamespaceDataSourceStats(t *testing.T) {
+	two := []*schedule.ScheduleListEntry{{ScheduleId: "a"}, {ScheduleId: "b"}}
+
+	tests := []struct {
+		name          string
+		pages         [][]*schedule.ScheduleListEntry
+		err           error
+		wantSchedules int64
+		wantWarning   bool
+		wantErr       bool
+	}{
+		{name: "all pages", pages: [][]*schedule.ScheduleListEntry{two, two}, wantSchedules: 4},
+		{name: "transient failure after the first page", pages: [][]*schedule.ScheduleListEntry{two, two}, err: status.Error(codes.Unavailable, "connection reset"), wantSchedules: 4, wantWarning: true},
+		{name: "transient failure of the first page", err: status.Error(codes.Unavailable, "connection reset"), wantErr: true},
+		{name: "permanent failure after the first page", pages: [][]*schedule.ScheduleListEntry{two}, err: status.Error(codes.PermissionDenied, "denied"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &NamespaceDataSource{
+				client:         &pagedScheduleClient{pages: tt.pages, err: tt.err},
+				operatorClient: searchAttributeCountClient{},
+			}
+
+			var diags diag.Diagnostics
+			stats, err := d.stats(context.Background(), "orders", &diags)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("stats() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := stats.Schedules.ValueInt64(); got != tt.wantSchedules {
+				t.Errorf("stats() schedules = %d, want %d", got, tt.wantSchedules)
+			}
+			if got := stats.RunningWorkflows.ValueInt64(); got != 3 {
+				t.Errorf("stats() running workflows = %d, want 3", got)
+			}
+			if !tt.wantWarning {
+				if len(diags) != 0 {
+					t.Errorf("stats() diagnostics = %v, want none", diags)
+				}
+				return
+			}
+			if len(diags) != 1 || diags[0].Summary() != summaryPartialList {
+				t.Fatalf("stats() diagnostics = %v, want one partial list warning", diags)
+			}
+			if detail := diags[0].Detail(); !strings.Contains(detail, "Counted 4 schedules in 2 pages") || strings.Contains(detail, "times") {
+				t.Errorf("stats() warning = %q", detail)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package provider_test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -21,6 +22,39 @@ data "temporal_namespace" "default" {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.temporal_namespace.default", "name", "default"),
 					resource.TestCheckResourceAttr("data.temporal_namespace.default", "description", "Default namespace for Temporal Server."),
+					resource.TestMatchResourceAttr("data.temporal_namespace.default", "raw_json", regexp.MustCompile(`"namespaceInfo":\{"name":"default"`)),
+					resource.TestCheckNoResourceAttr("data.temporal_namespace.default", "stats"),
+				),
+			},
+			// Failover version testing
+			{
+				Config: providerConfig + `
+data "temporal_namespace" "default" {
+	name                       = "default"
+	failover_version_increment = 10
+}
+`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.temporal_namespace.default", "failover_version"),
+					resource.TestCheckResourceAttr("data.temporal_namespace.default", "clusters.0.cluster_name", "active"),
+					resource.TestCheckResourceAttrSet("data.temporal_namespace.default", "clusters.0.initial_failover_version"),
+					resource.TestCheckResourceAttrSet("data.temporal_namespace.default", "clusters.0.next_failover_version"),
+				),
+			},
+			// Stats testing
+			{
+				Config: providerConfig + `
+data "temporal_namespace" "default" {
+	name          = "default"
+	include_stats = true
+}
+`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.temporal_namespace.default", "stats.schedules"),
+					resource.TestCheckResourceAttrSet("data.temporal_namespace.default", "stats.custom_search_attributes"),
+					resource.TestCheckResourceAttrSet("data.temporal_namespace.default", "stats.running_workflows"),
 				),
 			},
 		},
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/schedule/v1"
+	"go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/ganievs/terraform-provider-temporal/internal/convert"
+)
+
+const (
+	defaultHealthCheckScheduleID   = "namespace-health-check"
+	defaultHealthCheckWorkflowType = "HealthCheck"
+	defaultHealthCheckInterval     = "5m"
+
+	// healthCheckNamespaceTimeout is how long to wait for a newly registered namespace to accept the schedule.
+	// Frontends only learn about new namespaces when they refresh their namespace cache.
+	healthCheckNamespaceTimeout = 30 * time.Second
+)
+
+// healthCheckModel maps the health_check block of the namespace resource.
+type healthCheckModel struct {
+	TaskQueue    types.String `tfsdk:"task_queue"`
+	WorkflowType types.String `tfsdk:"workflow_type"`
+	Interval     types.String `tfsdk:"interval"`
+	ScheduleID   types.String `tfsdk:"schedule_id"`
+}
+
+var healthCheckAttrTypes = map[string]attr.Type{
+	"task_queue":    types.StringType,
+	"workflow_type": types.StringType,
+	"interval":      types.StringType,
+	"schedule_id":   types.StringType,
+}
+
+func (m healthCheckModel) scheduleID() string {
+	if m.ScheduleID.ValueString() == "" {
+		return defaultHealthCheckScheduleID
+	}
+	return m.ScheduleID.ValueString()
+}
+
+// schedule builds the schedule that starts the health check workflow. Only one run is kept at a time,
+// so a health check that does not complete shows up as skipped actions rather than piling up.
+func (m healthCheckModel) schedule() *schedule.Schedule {
+	workflowType := m.WorkflowType.ValueString()
+	if workflowType == "" {
+		workflowType = defaultHealthCheckWorkflowType
+	}
+	interval := m.Interval
+	if interval.ValueString() == "" {
+		interval = types.StringValue(defaultHealthCheckInterval)
+	}
+	// The value has already been checked by the schema validator.
+	every, _ := convert.DurationValue(interval)
+
+	return &schedule.Schedule{
+		Spec: &schedule.ScheduleSpec{
+			Interval: []*schedule.IntervalSpec{{Interval: every}},
+		},
+		Action: &schedule.ScheduleAction{
+			Action: &schedule.ScheduleAction_StartWorkflow{
+				StartWorkflow: &workflow.NewWorkflowExecutionInfo{
+					WorkflowId:         m.scheduleID(),
+					WorkflowType:       &common.WorkflowType{Name: workflowType},
+					TaskQueue:          &taskqueue.TaskQueue{Name: m.TaskQueue.ValueString(), Kind: enums.TASK_QUEUE_KIND_NORMAL},
+					WorkflowRunTimeout: every,
+				},
+			},
+		},
+		Policies: &schedule.SchedulePolicies{
+			OverlapPolicy: enums.SCHEDULE_OVERLAP_POLICY_SKIP,
+		},
+	}
+}
+
+// healthCheckFromObject converts the health_check block, returning false if it is not set.
+func healthCheckFromObject(ctx context.Context, object types.Object) (healthCheckModel, bool, diag.Diagnostics) {
+	var m healthCheckModel
+	if object.IsNull() || object.IsUnknown() {
+		return m, false, nil
+	}
+	diags := object.As(ctx, &m, basetypes.ObjectAsOptions{})
+	return m, true, diags
+}
+
+// createHealthCheck creates the health check schedule, waiting for a newly registered namespace to become available.
+func createHealthCheck(ctx context.Context, client workflowservice.WorkflowServiceClient, namespace string, m healthCheckModel) error {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckNamespaceTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(propagationInterval(ctx))
+	defer ticker.Stop()
+
+	for {
+		_, err := client.CreateSchedule(ctx, &workflowservice.CreateScheduleRequest{
+			Namespace:  namespace,
+			ScheduleId: m.scheduleID(),
+			Schedule:   m.schedule(),
+		})
+		if status.Code(err) != codes.NotFound {
+			return err
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return fmt.Errorf("namespace %s did not become available: %w", namespace, err)
+		}
+	}
+}
+
+// updateHealthCheck applies the planned health check, creating, replacing or deleting the schedule as needed.
+func updateHealthCheck(ctx context.Context, client workflowservice.WorkflowServiceClient, namespace string, planned, prior healthCheckModel, hasPlanned, hadPrior bool) error {
+	if hadPrior && (!hasPlanned || planned.scheduleID() != prior.scheduleID()) {
+		if err := deleteHealthCheck(ctx, client, namespace, prior.scheduleID()); err != nil {
+			return err
+		}
+		hadPrior = false
+	}
+	if !hasPlanned {
+		return nil
+	}
+	if !hadPrior {
+		return createHealthCheck(ctx, client, namespace, planned)
+	}
+
+	_, err := client.UpdateSchedule(ctx, &workflowservice.UpdateScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: planned.scheduleID(),
+		Schedule:   planned.schedule(),
+	})
+	if status.Code(err) == codes.NotFound {
+		// The schedule was deleted outside Terraform.
+		return createHealthCheck(ctx, client, namespace, planned)
+	}
+	return err
+}
+
+// deleteHealthCheck deletes the health check schedule. A schedule that is already gone is not an error.
+func deleteHealthCheck(ctx context.Context, client workflowservice.WorkflowServiceClient, namespace, scheduleID string) error {
+	_, err := client.DeleteSchedule(ctx, &workflowservice.DeleteScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleID,
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	return err
+}
+
+// readHealthCheck returns the health_check block as found on the server, or a null object if the schedule is gone.
+// Values that were not configured stay null while the server uses their defaults.
+func readHealthCheck(ctx context.Context, client workflowservice.WorkflowServiceClient, namespace string, prior healthCheckModel) (types.Object, error) {
+	null := types.ObjectNull(healthCheckAttrTypes)
+
+	described, err := client.DescribeSchedule(ctx, &workflowservice.DescribeScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: prior.scheduleID(),
+	})
+	if status.Code(err) == codes.NotFound {
+		return null, nil
+	}
+	if err != nil {
+		return null, err
+	}
+
+	start := described.GetSchedule().GetAction().GetStartWorkflow()
+	m := healthCheckModel{
+		TaskQueue:    types.StringValue(start.GetTaskQueue().GetName()),
+		WorkflowType: defaultedString(start.GetWorkflowType().GetName(), defaultHealthCheckWorkflowType, prior.WorkflowType),
+		ScheduleID:   prior.ScheduleID,
+		Interval:     prior.Interval,
+	}
+
+	var every *durationpb.Duration
+	if intervals := described.GetSchedule().GetSpec().GetInterval(); len(intervals) > 0 {
+		every = intervals[0].GetInterval()
+	}
+	configured := prior.Interval
+	if configured.ValueString() == "" {
+		configured = types.StringValue(defaultHealthCheckInterval)
+	}
+	if d, _ := convert.DurationValue(configured); d.AsDuration() != every.AsDuration() {
+		m.Interval = convert.Duration(every)
+	}
+
+	object, diags := types.ObjectValueFrom(ctx, healthCheckAttrTypes, m)
+	if diags.HasError() {
+		return null, fmt.Errorf("unable to convert the health check schedule: %v", diags)
+	}
+	return object, nil
+}
+
+// defaultedString returns the server value, or null if the prior value is null and the server uses the default.
+func defaultedString(server, defaultValue string, prior types.String) types.String {
+	if prior.IsNull() && server == defaultValue {
+		return prior
+	}
+	return types.StringValue(server)
+}
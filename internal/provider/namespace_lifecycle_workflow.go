@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/ganievs/terraform-provider-temporal/internal/convert"
+	"github.com/ganievs/terraform-provider-temporal/temporalclient"
+)
+
+const (
+	defaultOnCreateWorkflowID    = "terraform-on-create"
+	defaultPreDestroyWorkflowID  = "terraform-pre-destroy"
+	defaultLifecycleWorkflowWait = "10m"
+)
+
+// lifecycleWorkflowModel maps the on_create_workflow and pre_destroy_workflow blocks of the namespace resource.
+type lifecycleWorkflowModel struct {
+	WorkflowType types.String `tfsdk:"workflow_type"`
+	TaskQueue    types.String `tfsdk:"task_queue"`
+	WorkflowID   types.String `tfsdk:"workflow_id"`
+	Input        types.List   `tfsdk:"input"`
+	Wait         types.Bool   `tfsdk:"wait"`
+	Timeout      types.String `tfsdk:"timeout"`
+}
+
+var lifecycleWorkflowAttrTypes = map[string]attr.Type{
+	"workflow_type": types.StringType,
+	"task_queue":    types.StringType,
+	"workflow_id":   types.StringType,
+	"input":         types.ListType{ElemType: types.StringType},
+	"wait":          types.BoolType,
+	"timeout":       types.StringType,
+}
+
+// lifecycleWorkflowBlock returns the schema of a lifecycle workflow block.
+func lifecycleWorkflowBlock(description, defaultWorkflowID string) schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: description + " The workflow must be implemented by a worker polling `task_queue` in the namespace. " +
+			"Changing the block does not start the workflow again.",
+		Attributes: map[string]schema.Attribute{
+			"workflow_type": schema.StringAttribute{
+				MarkdownDescription: "Workflow type to start",
+				Required:            true,
+			},
+			"task_queue": schema.StringAttribute{
+				MarkdownDescription: "Task queue the workflow is started on",
+				Required:            true,
+			},
+			"workflow_id": schema.StringAttribute{
+				MarkdownDescription: "Workflow ID. Defaults to `" + defaultWorkflowID + "`.",
+				Optional:            true,
+			},
+			"input": schema.ListAttribute{
+				MarkdownDescription: "Arguments of the workflow, each a JSON document, e.g. `[jsonencode({ tier = \"gold\" })]`. They are sent as `json/plain` payloads, the encoding of the SDKs' default data converter.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"wait": schema.BoolAttribute{
+				MarkdownDescription: "Wait for the workflow to complete, and fail if it does not complete successfully. Defaults to `false`, which only starts it.",
+				Optional:            true,
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "Execution timeout of the workflow, and how long the provider waits for it when `wait` is set, e.g. `5m`. Defaults to `" + defaultLifecycleWorkflowWait + "`.",
+				Optional:            true,
+				Validators: []validator.String{
+					isDuration(),
+				},
+			},
+		},
+	}
+}
+
+// lifecycleWorkflowFromObject converts a lifecycle workflow block, returning false if it is not set.
+func lifecycleWorkflowFromObject(ctx context.Context, object types.Object) (lifecycleWorkflowModel, bool, diag.Diagnostics) {
+	var m lifecycleWorkflowModel
+	if object.IsNull() || object.IsUnknown() {
+		return m, false, nil
+	}
+	diags := object.As(ctx, &m, basetypes.ObjectAsOptions{})
+	return m, true, diags
+}
+
+// runLifecycleWorkflow starts the workflow of a lifecycle block and, with wait, waits for it to complete. A newly
+// registered namespace is retried until the frontend knows it, as for the health check schedule.
+func runLifecycleWorkflow(ctx context.Context, client workflowservice.WorkflowServiceClient, namespace string, m lifecycleWorkflowModel, defaultWorkflowID string) error {
+	var inputs []string
+	if diags := m.Input.ElementsAs(ctx, &inputs, false); diags.HasError() {
+		return fmt.Errorf("unable to read the workflow input: %v", diags)
+	}
+	input, err := convert.Payloads(inputs)
+	if err != nil {
+		return fmt.Errorf("invalid workflow input: %w", err)
+	}
+
+	workflowID := m.WorkflowID.ValueString()
+	if workflowID == "" {
+		workflowID = defaultWorkflowID
+	}
+	timeout := m.Timeout.ValueString()
+	if timeout == "" {
+		timeout = defaultLifecycleWorkflowWait
+	}
+	// The value has already been checked by the schema validator.
+	wait, _ := time.ParseDuration(timeout)
+
+	request := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:                namespace,
+		WorkflowId:               workflowID,
+		WorkflowType:             &common.WorkflowType{Name: m.WorkflowType.ValueString()},
+		TaskQueue:                &taskqueue.TaskQueue{Name: m.TaskQueue.ValueString(), Kind: enums.TASK_QUEUE_KIND_NORMAL},
+		Input:                    input,
+		WorkflowExecutionTimeout: durationpb.New(wait),
+	}
+
+	started, err := startWhenAvailable(ctx, client, request)
+	if err != nil {
+		return err
+	}
+	if !m.Wait.ValueBool() {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, wait)
+	defer cancel()
+	return temporalclient.AwaitWorkflow(ctx, client, namespace, workflowID, started.GetRunId())
+}
+
+// startWhenAvailable starts a workflow, waiting for a newly registered namespace to become available.
+func startWhenAvailable(ctx context.Context, client workflowservice.WorkflowServiceClient, request *workflowservice.StartWorkflowExecutionRequest) (*workflowservice.StartWorkflowExecutionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckNamespaceTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(propagationInterval(ctx))
+	defer ticker.Stop()
+
+	for {
+		started, err := client.StartWorkflowExecution(ctx, request)
+		if status.Code(err) != codes.NotFound {
+			return started, err
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("namespace %s did not become available: %w", request.GetNamespace(), err)
+		}
+	}
+}
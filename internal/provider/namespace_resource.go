@@ -2,13 +2,16 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"time"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/durationpb"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"go.temporal.io/api/enums/v1"
@@ -25,18 +29,23 @@ import (
 	"go.temporal.io/api/replication/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
-	"google.golang.org/grpc"
+
+	"github.com/ganievs/terraform-provider-temporal/internal/convert"
+	"github.com/ganievs/terraform-provider-temporal/models"
 )
 
 const (
-	// day represents the number of nanoseconds in a day, used for time calculations.
-	day = 24 * time.Hour
+	// managedByKey and workspaceKey are the namespace data keys the provider stamps namespaces with when
+	// managed_by_workspace is set, so that ListNamespaces audits can tell managed namespaces apart.
+	managedByKey = "managed-by"
+	workspaceKey = "terraform-workspace"
 )
 
 var (
 	_ resource.Resource                = &NamespaceResource{}
 	_ resource.ResourceWithConfigure   = &NamespaceResource{}
 	_ resource.ResourceWithImportState = &NamespaceResource{}
+	_ resource.ResourceWithModifyPlan  = &NamespaceResource{}
 )
 
 // NewNamespaceResource creates a new instance of NamespaceResource.
@@ -46,22 +55,7 @@ func NewNamespaceResource() resource.Resource {
 
 // NamespaceResource a Temporal namespace resource implementation.
 type NamespaceResource struct {
-	client grpc.ClientConnInterface
-}
-
-// NamespaceResourceModel defines the data schema for a Temporal namespace resource.
-type NamespaceResourceModel struct {
-	Name                    types.String `tfsdk:"name"`
-	Id                      types.String `tfsdk:"id"`
-	Description             types.String `tfsdk:"description"`
-	OwnerEmail              types.String `tfsdk:"owner_email"`
-	Retention               types.Int64  `tfsdk:"retention"`
-	ActiveClusterName       types.String `tfsdk:"active_cluster_name"`
-	HistoryArchivalState    types.String `tfsdk:"history_archival_state"`
-	HistoryArchivalUri      types.String `tfsdk:"history_archival_uri"`
-	VisibilityArchivalState types.String `tfsdk:"visibility_archival_state"`
-	VisibilityArchivalUri   types.String `tfsdk:"visibility_archival_uri"`
-	IsGlobalNamespace       types.Bool   `tfsdk:"is_global_namespace"`
+	client *TemporalClient
 }
 
 // Metadata sets the metadata for the namespace resource, specifically the type name.
@@ -93,6 +87,8 @@ func (r *NamespaceResource) Schema(ctx context.Context, req resource.SchemaReque
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
 			},
+			"cluster":     clusterAttribute(),
+			"rpc_timeout": rpcTimeoutAttribute(),
 			"owner_email": schema.StringAttribute{
 				MarkdownDescription: "Namespace Owner Email",
 				Required:            true,
@@ -136,6 +132,73 @@ func (r *NamespaceResource) Schema(ctx context.Context, req resource.SchemaReque
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Namespace lifecycle state, either `Registered` or `Deprecated`. The server decides which transitions are allowed; deprecating a namespace is usually one-way.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Registered", "Deprecated"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data": schema.MapAttribute{
+				MarkdownDescription: "Custom key-value data attached to the namespace. The server merges updates into the existing data, so keys removed from the configuration stay on the namespace. " +
+					"Only keys in `data` or `sensitive_data` are tracked: keys added outside Terraform do not show up as drift, and neither do the markers added with the provider's `managed_by_workspace` attribute. " +
+					"Importing a namespace reads all its keys apart from those markers into `data`.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"sensitive_data": schema.MapAttribute{
+				MarkdownDescription: "Custom key-value data attached to the namespace whose values are hidden in plan output, such as webhook URLs or tokens. Keys must not overlap with `data`. " +
+					"Sensitive keys are chosen by moving them from `data` to this map, not by listing them. Import cannot tell which keys are sensitive, so after an import, move them here from `data`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"delete_snapshot_path": schema.StringAttribute{
+				MarkdownDescription: "Path of a local JSON file to write the namespace description, its search attributes and the IDs of its schedules to before the namespace is deleted, " +
+					"as a record to restore it from after an accidental deletion. The file is only readable by the user running Terraform, since namespace data may hold secrets. " +
+					"The namespace is not deleted if the snapshot cannot be written.",
+				Optional: true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"health_check": schema.SingleNestedBlock{
+				MarkdownDescription: "Schedule created alongside the namespace that starts a no-op workflow at a fixed interval, giving a per-namespace liveness signal: " +
+					"skipped or failed runs show that no worker polls the task queue or that the namespace is unhealthy. The workflow itself must be implemented by a worker on `task_queue`. " +
+					"The schedule is deleted together with the namespace, or when the block is removed.",
+				Attributes: map[string]schema.Attribute{
+					"task_queue": schema.StringAttribute{
+						MarkdownDescription: "Task queue the health check workflow is started on",
+						Required:            true,
+					},
+					"workflow_type": schema.StringAttribute{
+						MarkdownDescription: "Workflow type to start. Defaults to `" + defaultHealthCheckWorkflowType + "`.",
+						Optional:            true,
+					},
+					"interval": schema.StringAttribute{
+						MarkdownDescription: "How often to start the workflow, e.g. `1m`. Each run times out after one interval. Defaults to `" + defaultHealthCheckInterval + "`.",
+						Optional:            true,
+						Validators: []validator.String{
+							isDuration(),
+						},
+					},
+					"schedule_id": schema.StringAttribute{
+						MarkdownDescription: "ID of the schedule, also used as the workflow ID. Defaults to `" + defaultHealthCheckScheduleID + "`.",
+						Optional:            true,
+					},
+				},
+			},
+			"on_create_workflow": lifecycleWorkflowBlock(
+				"Workflow started once after the namespace is registered, and after its health check schedule is created, e.g. to seed it or notify an owner.",
+				defaultOnCreateWorkflowID),
+			"pre_destroy_workflow": lifecycleWorkflowBlock(
+				"Workflow started before the namespace is deleted, and before its snapshot is written, e.g. to drain or export it. "+
+					"With `wait`, the namespace is not deleted unless the workflow completes.",
+				defaultPreDestroyWorkflowID),
 		},
 	}
 }
@@ -150,11 +213,11 @@ func (r *NamespaceResource) Configure(ctx context.Context, req resource.Configur
 	}
 
 	tflog.Info(ctx, "Configured Temporal Namespace client", map[string]any{"success": true})
-	client, ok := req.ProviderData.(grpc.ClientConnInterface)
+	client, ok := req.ProviderData.(*TemporalClient)
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected grpc.ClientConnInterface), got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			summaryResourceConfType,
+			fmt.Sprintf("Expected *provider.TemporalClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -167,9 +230,7 @@ func (r *NamespaceResource) Configure(ctx context.Context, req resource.Configur
 
 // Create is responsible for creating a new namespace in Temporal.
 func (r *NamespaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data NamespaceResourceModel
-
-	client := workflowservice.NewWorkflowServiceClient(r.client)
+	var data models.NamespaceResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -178,28 +239,55 @@ func (r *NamespaceResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	retention := durationpb.New(time.Duration(data.Retention.ValueInt64()) * day)
+	ctx, done := r.client.timeOperation(ctx, "create", "temporal_namespace."+data.Name.ValueString())
+	defer done()
+	ctx = withRPCTimeout(ctx, data.RPCTimeout)
+	ctx = withDevMode(ctx, r.client.devMode)
+
+	cluster, diags := r.client.cluster(data.Cluster)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := cluster.workflowService
+
+	historyArchival, visibilityArchival, diags := namespaceArchivalStates(data)
+	resp.Diagnostics.Append(diags...)
+	nsData, diags := namespaceData(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client.stampNamespaceData(nsData)
 
 	request := &workflowservice.RegisterNamespaceRequest{
 		Namespace:                        data.Name.ValueString(),
 		Description:                      data.Description.ValueString(),
 		OwnerEmail:                       data.OwnerEmail.ValueString(),
-		WorkflowExecutionRetentionPeriod: retention,
+		WorkflowExecutionRetentionPeriod: convert.DaysValue(data.Retention),
 		ActiveClusterName:                data.ActiveClusterName.ValueString(),
-		VisibilityArchivalState:          ArchivalState[data.VisibilityArchivalState.ValueString()],
+		VisibilityArchivalState:          visibilityArchival,
 		VisibilityArchivalUri:            data.VisibilityArchivalUri.ValueString(),
-		HistoryArchivalState:             ArchivalState[data.HistoryArchivalState.ValueString()],
+		HistoryArchivalState:             historyArchival,
 		HistoryArchivalUri:               data.HistoryArchivalUri.ValueString(),
 		IsGlobalNamespace:                data.IsGlobalNamespace.ValueBool(),
+		Data:                             nsData,
+	}
+	if r.client.devMode {
+		devModeRegisterRequest(request)
 	}
 
 	_, err := client.RegisterNamespace(ctx, request)
+	if err != nil && r.client.devMode && request.GetNamespace() == devServerNamespace && status.Code(err) == codes.AlreadyExists {
+		tflog.Info(ctx, "Adopting the namespace created by the dev server", map[string]any{"namespace": devServerNamespace})
+		err = adoptDevServerNamespace(ctx, client, request)
+	}
 	if err != nil {
 		if _, ok := err.(*serviceerror.NamespaceAlreadyExists); !ok {
-			resp.Diagnostics.AddError("Request error", "namespace registration failed: "+err.Error())
+			resp.Diagnostics.AddError(summaryRequestError, "namespace registration failed: "+requestErrorDetail(err))
 			return
 		}
-		resp.Diagnostics.AddError(data.Name.ValueString(), "namespace is already registered: "+err.Error())
+		resp.Diagnostics.AddError(summaryNamespaceAlreadyRegistered, "namespace is already registered: "+err.Error())
 		return
 	}
 
@@ -210,34 +298,92 @@ func (r *NamespaceResource) Create(ctx context.Context, req resource.CreateReque
 		Namespace: data.Name.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Namespace info, got error: %s", err))
+		resp.Diagnostics.AddError(summaryClientError, fmt.Sprintf("Unable to create Namespace info, got error: %s", err))
 		return
 	}
 
+	// The state is validated by the schema.
+	state := ns.GetNamespaceInfo().GetState()
+	if planned, _ := convert.EnumValue(data.State, enums.NamespaceStateFromString); !data.State.IsUnknown() && planned != state {
+		updated, err := client.UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+			Namespace:  data.Name.ValueString(),
+			UpdateInfo: &namespace.UpdateNamespaceInfo{State: planned},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(summaryRequestError, fmt.Sprintf("Unable to set namespace state to %s: %s", data.State.ValueString(), requestErrorDetail(err)))
+			return
+		}
+		state = updated.GetNamespaceInfo().GetState()
+	}
+
+	warnNormalized(&resp.Diagnostics, "name", data.Name, ns.GetNamespaceInfo().GetName())
+	normalized := normalizedValues{}
+	normalized.add(&resp.Diagnostics, "description", data.Description, ns.GetNamespaceInfo().GetDescription())
+	normalized.add(&resp.Diagnostics, "owner_email", data.OwnerEmail, ns.GetNamespaceInfo().GetOwnerEmail())
+	resp.Diagnostics.Append(normalized.save(ctx, resp.Private)...)
+
+	configured := data
 	data.Id = types.StringValue(ns.NamespaceInfo.GetId())
 	data.ActiveClusterName = types.StringValue(ns.GetReplicationConfig().GetActiveClusterName())
 	data.HistoryArchivalUri = types.StringValue(ns.GetConfig().GetHistoryArchivalUri())
 	data.VisibilityArchivalUri = types.StringValue(ns.GetConfig().GetVisibilityArchivalUri())
+	data.State = convert.Enum(state)
+	if r.client.devMode {
+		keepDevModeSettings(&data, configured)
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	healthCheck, ok, diags := healthCheckFromObject(ctx, data.HealthCheck)
+	resp.Diagnostics.Append(diags...)
+	if ok && !resp.Diagnostics.HasError() {
+		if err := createHealthCheck(ctx, client, data.Name.ValueString(), healthCheck); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("health_check"), summaryRequestError,
+				fmt.Sprintf("The namespace was registered, but its health check schedule could not be created: %s\n\n"+
+					"Terraform marks the namespace as tainted. Run terraform untaint on it and apply again to retry the schedule without replacing the namespace.",
+					requestErrorDetail(err)))
+			return
+		}
+	}
+
+	onCreate, ok, diags := lifecycleWorkflowFromObject(ctx, data.OnCreateWorkflow)
+	resp.Diagnostics.Append(diags...)
+	if ok && !resp.Diagnostics.HasError() {
+		if err := runLifecycleWorkflow(ctx, client, data.Name.ValueString(), onCreate, defaultOnCreateWorkflowID); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("on_create_workflow"), summaryRequestError,
+				fmt.Sprintf("The namespace was registered, but its on_create_workflow did not succeed: %s\n\n"+
+					"Terraform marks the namespace as tainted. Run terraform untaint on it to keep the namespace without running the workflow again.",
+					requestErrorDetail(err)))
+		}
+	}
 }
 
 // Read is responsible for reading the current state of a Temporal namespace.
 // It fetches the current configuration of the namespace and updates the Terraform state.
 func (r *NamespaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state NamespaceResourceModel
-
-	client := workflowservice.NewWorkflowServiceClient(r.client)
+	var state models.NamespaceResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, done := r.client.timeOperation(ctx, "read", "temporal_namespace."+state.Name.ValueString())
+	defer done()
+	ctx = withRPCTimeout(ctx, state.RPCTimeout)
+
+	cluster, diags := r.client.cluster(state.Cluster)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := cluster.workflowService
+
 	namespace := state.Name.ValueString()
 	ns, err := client.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
 		Namespace: namespace,
@@ -248,25 +394,63 @@ func (r *NamespaceResource) Read(ctx context.Context, req resource.ReadRequest,
 			tflog.Warn(ctx, "Namespace not found", map[string]interface{}{"err": err, "namespace": namespace})
 			return
 		} else {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Namespace info, got error: %s", err))
+			resp.Diagnostics.AddError(summaryClientError, fmt.Sprintf("Unable to read Namespace info, got error: %s", requestErrorDetail(err)))
 			return
 		}
 	}
 
 	tflog.Trace(ctx, "read a Temporal Namespace resource")
 
-	data := &NamespaceResourceModel{
+	normalized, diags := loadNormalizedValues(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	data := &models.NamespaceResourceModel{
 		Id:                      types.StringValue(ns.NamespaceInfo.GetId()),
 		Name:                    state.Name,
-		Description:             types.StringValue(ns.NamespaceInfo.GetDescription()),
-		OwnerEmail:              types.StringValue(ns.NamespaceInfo.GetOwnerEmail()),
-		Retention:               types.Int64Value(int64(ns.Config.WorkflowExecutionRetentionTtl.AsDuration().Hours() / 24)),
+		Description:             normalized.value("description", ns.NamespaceInfo.GetDescription()),
+		OwnerEmail:              normalized.value("owner_email", ns.NamespaceInfo.GetOwnerEmail()),
+		Retention:               convert.Days(ns.GetConfig().GetWorkflowExecutionRetentionTtl()),
 		ActiveClusterName:       types.StringValue(ns.GetReplicationConfig().GetActiveClusterName()),
-		HistoryArchivalState:    types.StringValue(ns.Config.GetHistoryArchivalState().String()),
+		HistoryArchivalState:    convert.Enum(ns.GetConfig().GetHistoryArchivalState()),
 		HistoryArchivalUri:      types.StringValue(ns.Config.GetHistoryArchivalUri()),
-		VisibilityArchivalState: types.StringValue(ns.Config.GetVisibilityArchivalState().String()),
+		VisibilityArchivalState: convert.Enum(ns.GetConfig().GetVisibilityArchivalState()),
 		VisibilityArchivalUri:   types.StringValue(ns.Config.GetVisibilityArchivalUri()),
 		IsGlobalNamespace:       types.BoolValue(ns.GetIsGlobalNamespace()),
+		State:                   convert.Enum(ns.GetNamespaceInfo().GetState()),
+		Data:                    managedNamespaceData(ns.NamespaceInfo.GetData(), state.Data),
+		SensitiveData:           managedNamespaceData(ns.NamespaceInfo.GetData(), state.SensitiveData),
+		Cluster:                 state.Cluster,
+		DeleteSnapshotPath:      state.DeleteSnapshotPath,
+		RPCTimeout:              state.RPCTimeout,
+		OnCreateWorkflow:        state.OnCreateWorkflow,
+		PreDestroyWorkflow:      state.PreDestroyWorkflow,
+	}
+	if r.client.devMode {
+		keepDevModeSettings(data, state)
+	}
+
+	// The schedule is only looked up when the state has it, so imported namespaces do not adopt a schedule by accident.
+	data.HealthCheck = state.HealthCheck
+	if priorHealthCheck, ok, diags := healthCheckFromObject(ctx, state.HealthCheck); ok {
+		resp.Diagnostics.Append(diags...)
+		data.HealthCheck, err = readHealthCheck(ctx, client, namespace, priorHealthCheck)
+		if err != nil {
+			resp.Diagnostics.AddError(summaryClientError, fmt.Sprintf("Unable to read the health check schedule, got error: %s", requestErrorDetail(err)))
+			return
+		}
+	}
+
+	if ns.NamespaceInfo.GetState() == enums.NAMESPACE_STATE_DEPRECATED {
+		resp.Diagnostics.AddWarning(summaryNamespaceDeprecated,
+			fmt.Sprintf("Namespace %q is deprecated: new workflow executions cannot be started in it.", namespace))
+	}
+
+	// An imported namespace has no data keys in its state yet to track, so the first read takes all of them.
+	imported, diags := req.Private.GetKey(ctx, importedKey)
+	resp.Diagnostics.Append(diags...)
+	if len(imported) > 0 {
+		data.Data = importedNamespaceData(ns.NamespaceInfo.GetData())
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedKey, nil)...)
 	}
 
 	// Set refreshed state
@@ -275,29 +459,64 @@ func (r *NamespaceResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Update modifies an existing Temporal namespace based on Terraform configuration changes.
 func (r *NamespaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data NamespaceResourceModel
-
-	client := workflowservice.NewWorkflowServiceClient(r.client)
+	var data, state models.NamespaceResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	retention := durationpb.New(time.Duration(data.Retention.ValueInt64()) * day)
+	ctx, done := r.client.timeOperation(ctx, "update", "temporal_namespace."+data.Name.ValueString())
+	defer done()
+	ctx = withRPCTimeout(ctx, data.RPCTimeout)
+	ctx = withDevMode(ctx, r.client.devMode)
+
+	cluster, diags := r.client.cluster(data.Cluster)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := cluster.workflowService
+
+	// Leaving the state unspecified tells the server to keep the current one. The state is validated by the schema.
+	namespaceState := enums.NAMESPACE_STATE_UNSPECIFIED
+	if !data.State.Equal(state.State) {
+		namespaceState, _ = convert.EnumValue(data.State, enums.NamespaceStateFromString)
+	}
+
+	historyArchival, visibilityArchival, diags := namespaceArchivalStates(data)
+	resp.Diagnostics.Append(diags...)
+	nsData, diags := namespaceData(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	priorData, priorDiags := namespaceData(ctx, state)
+	resp.Diagnostics.Append(priorDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for key := range priorData {
+		if _, ok := nsData[key]; !ok {
+			resp.Diagnostics.AddWarning(summaryNamespaceKeyNotRemoved,
+				fmt.Sprintf("Key %q was removed from the configuration, but the Temporal API cannot delete namespace data keys. It remains on the namespace and is no longer tracked.", key))
+		}
+	}
+	r.client.stampNamespaceData(nsData)
 
 	request := &workflowservice.UpdateNamespaceRequest{
 		Namespace: data.Name.ValueString(),
 		UpdateInfo: &namespace.UpdateNamespaceInfo{
 			Description: data.Description.ValueString(),
 			OwnerEmail:  data.OwnerEmail.ValueString(),
+			State:       namespaceState,
+			Data:        nsData,
 		},
 		Config: &namespace.NamespaceConfig{
-			WorkflowExecutionRetentionTtl: retention,
-			VisibilityArchivalState:       ArchivalState[data.VisibilityArchivalState.ValueString()],
+			WorkflowExecutionRetentionTtl: convert.DaysValue(data.Retention),
+			VisibilityArchivalState:       visibilityArchival,
 			VisibilityArchivalUri:         data.VisibilityArchivalUri.ValueString(),
-			HistoryArchivalState:          ArchivalState[data.HistoryArchivalState.ValueString()],
+			HistoryArchivalState:          historyArchival,
 			HistoryArchivalUri:            data.HistoryArchivalUri.ValueString(),
 		},
 		ReplicationConfig: &replication.NamespaceReplicationConfig{
@@ -306,25 +525,51 @@ func (r *NamespaceResource) Update(ctx context.Context, req resource.UpdateReque
 		// promote local namespace to global namespace. Ignored if namespace is already global namespace.
 		PromoteNamespace: data.IsGlobalNamespace.ValueBool(),
 	}
+	if r.client.devMode {
+		devModeUpdateRequest(request)
+	}
 
 	ns, err := client.UpdateNamespace(ctx, request)
 	if err != nil {
 		if _, ok := err.(*serviceerror.NamespaceAlreadyExists); !ok {
-			resp.Diagnostics.AddError("Request error", "namespace registration failed: "+err.Error())
+			resp.Diagnostics.AddError(summaryRequestError, "namespace registration failed: "+requestErrorDetail(err))
 			return
 		} else {
-			resp.Diagnostics.AddError(data.Name.ValueString(), "namespace is already registered: "+err.Error())
+			resp.Diagnostics.AddError(summaryNamespaceAlreadyRegistered, "namespace is already registered: "+err.Error())
 			return
 		}
 	}
 
+	normalized := normalizedValues{}
+	normalized.add(&resp.Diagnostics, "description", data.Description, ns.GetNamespaceInfo().GetDescription())
+	normalized.add(&resp.Diagnostics, "owner_email", data.OwnerEmail, ns.GetNamespaceInfo().GetOwnerEmail())
+	resp.Diagnostics.Append(normalized.save(ctx, resp.Private)...)
+
+	configured := data
 	data.Id = types.StringValue(ns.NamespaceInfo.GetId())
 	data.ActiveClusterName = types.StringValue(ns.GetReplicationConfig().GetActiveClusterName())
 	data.HistoryArchivalUri = types.StringValue(ns.GetConfig().GetHistoryArchivalUri())
 	data.VisibilityArchivalUri = types.StringValue(ns.GetConfig().GetVisibilityArchivalUri())
+	data.State = convert.Enum(ns.GetNamespaceInfo().GetState())
+	if r.client.devMode {
+		keepDevModeSettings(&data, configured)
+	}
 	tflog.Info(ctx, fmt.Sprintf("The namespace: %s is successfully registered", data.Name))
 	tflog.Trace(ctx, "created a resource")
 
+	planned, hasPlanned, diags := healthCheckFromObject(ctx, data.HealthCheck)
+	resp.Diagnostics.Append(diags...)
+	prior, hadPrior, diags := healthCheckFromObject(ctx, state.HealthCheck)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := updateHealthCheck(ctx, client, data.Name.ValueString(), planned, prior, hasPlanned, hadPrior); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("health_check"), summaryRequestError,
+			fmt.Sprintf("Unable to update the health check schedule: %s", requestErrorDetail(err)))
+		return
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -334,9 +579,7 @@ func (r *NamespaceResource) Update(ctx context.Context, req resource.UpdateReque
 
 // Delete removes a Temporal namespace from both Temporal and the Terraform state.
 func (r *NamespaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data NamespaceResourceModel
-
-	client := operatorservice.NewOperatorServiceClient(r.client)
+	var data models.NamespaceResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -344,21 +587,274 @@ func (r *NamespaceResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	ctx, done := r.client.timeOperation(ctx, "delete", "temporal_namespace."+data.Name.ValueString())
+	defer done()
+	ctx = withRPCTimeout(ctx, data.RPCTimeout)
+
+	cluster, diags := r.client.cluster(data.Cluster)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := cluster.operatorService
+
+	if r.client.devMode && data.Name.ValueString() == devServerNamespace {
+		resp.Diagnostics.AddWarning(summaryDefaultNamespaceKept,
+			"In dev_mode the namespace created by the dev server is kept. It is removed from the Terraform state only.")
+		return
+	}
+
+	if preDestroy, ok, diags := lifecycleWorkflowFromObject(ctx, data.PreDestroyWorkflow); ok {
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := runLifecycleWorkflow(ctx, cluster.workflowService, data.Name.ValueString(), preDestroy, defaultPreDestroyWorkflowID); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("pre_destroy_workflow"), summaryRequestError,
+				fmt.Sprintf("The pre_destroy_workflow of namespace %s did not succeed, the namespace was not deleted: %s", data.Name.ValueString(), requestErrorDetail(err)))
+			return
+		}
+	}
+
+	if snapshotPath := data.DeleteSnapshotPath.ValueString(); snapshotPath != "" {
+		if err := writeNamespaceSnapshot(ctx, cluster, data.Name.ValueString(), snapshotPath); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("delete_snapshot_path"),
+				summarySnapshot,
+				fmt.Sprintf("Unable to write the snapshot of namespace %s to %s, the namespace was not deleted: %s", data.Name.ValueString(), snapshotPath, requestErrorDetail(err)),
+			)
+			return
+		}
+		tflog.Info(ctx, "Wrote namespace snapshot", map[string]any{"path": snapshotPath})
+	}
+
+	// Deleting the namespace eventually deletes the schedule too, but stopping it first avoids health check
+	// failures while the namespace is being deleted.
+	if healthCheck, ok, _ := healthCheckFromObject(ctx, data.HealthCheck); ok {
+		if err := deleteHealthCheck(ctx, cluster.workflowService, data.Name.ValueString(), healthCheck.scheduleID()); err != nil {
+			tflog.Warn(ctx, "Unable to delete the health check schedule before the namespace", map[string]any{"err": err})
+		}
+	}
+
 	_, err := client.DeleteNamespace(ctx, &operatorservice.DeleteNamespaceRequest{
 		Namespace: data.Name.ValueString(),
 	})
 	if err != nil {
 		switch err.(type) {
 		case *serviceerror.NamespaceNotFound:
-			resp.Diagnostics.AddError("Request error", "Namespace not found: "+err.Error())
+			resp.Diagnostics.AddError(summaryRequestError, "Namespace not found: "+err.Error())
 			return
 		default:
-			resp.Diagnostics.AddError("Request error", "Unable to delete namespace: "+err.Error())
+			resp.Diagnostics.AddError(summaryRequestError, "Unable to delete namespace: "+requestErrorDetail(err))
+		}
+	}
+}
+
+// namespaceArchivalStates parses the archival states of a namespace, accepting both the shorthand, e.g. "Enabled",
+// and the full name of the state.
+func namespaceArchivalStates(model models.NamespaceResourceModel) (history, visibility enums.ArchivalState, diags diag.Diagnostics) {
+	history, err := convert.EnumValue(model.HistoryArchivalState, enums.ArchivalStateFromString)
+	if err != nil {
+		diags.AddAttributeError(path.Root("history_archival_state"), summaryInvalidArchival, err.Error())
+	}
+	visibility, err = convert.EnumValue(model.VisibilityArchivalState, enums.ArchivalStateFromString)
+	if err != nil {
+		diags.AddAttributeError(path.Root("visibility_archival_state"), summaryInvalidArchival, err.Error())
+	}
+	return history, visibility, diags
+}
+
+// namespaceData merges the data and sensitive_data attributes into the data map sent to the server.
+func namespaceData(ctx context.Context, model models.NamespaceResourceModel) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := make(map[string]string)
+	diags.Append(model.Data.ElementsAs(ctx, &data, false)...)
+
+	sensitive := make(map[string]string)
+	diags.Append(model.SensitiveData.ElementsAs(ctx, &sensitive, false)...)
+
+	for key, value := range sensitive {
+		if _, ok := data[key]; ok {
+			diags.AddAttributeError(path.Root("sensitive_data"), summaryDuplicateNamespaceKey,
+				fmt.Sprintf("Key %q is set in both data and sensitive_data.", key))
+			continue
+		}
+		data[key] = value
+	}
+
+	return data, diags
+}
+
+// stampNamespaceData adds the managed-by markers to the data sent to the server, if the provider sets a workspace.
+// Keys configured in data or sensitive_data take precedence. The markers are not tracked in the state, since
+// managedNamespaceData only reads back configured keys, so they never show up in a plan.
+func (c *TemporalClient) stampNamespaceData(data map[string]string) {
+	if c.workspace == "" {
+		return
+	}
+	markers := map[string]string{managedByKey: "terraform", workspaceKey: c.workspace}
+	for key, value := range markers {
+		if _, ok := data[key]; !ok {
+			data[key] = value
+		}
+	}
+}
+
+// managedNamespaceData returns the server values of the keys tracked in prior, ignoring keys managed outside Terraform.
+func managedNamespaceData(server map[string]string, prior types.Map) types.Map {
+	if prior.IsNull() || prior.IsUnknown() {
+		return prior
+	}
+
+	values := make(map[string]attr.Value)
+	for key := range prior.Elements() {
+		if value, ok := server[key]; ok {
+			values[key] = types.StringValue(value)
+		}
+	}
+
+	return types.MapValueMust(types.StringType, values)
+}
+
+// importedNamespaceData returns the data of an imported namespace, without the managed-by markers. Which keys
+// are sensitive is not known, so all of them go to data.
+func importedNamespaceData(server map[string]string) types.Map {
+	values := make(map[string]attr.Value)
+	for key, value := range server {
+		if key != managedByKey && key != workspaceKey {
+			values[key] = types.StringValue(value)
+		}
+	}
+	if len(values) == 0 {
+		return types.MapNull(types.StringType)
+	}
+
+	return types.MapValueMust(types.StringType, values)
+}
+
+// importedKey is the private state key set by ImportState, until the Read that follows the import.
+const importedKey = "imported"
+
+// normalizedValuesKey is the private state key of the values the server stored in a normalized form.
+const normalizedValuesKey = "normalized_values"
+
+// normalizedValue is a value as submitted and as stored by the server.
+type normalizedValue struct {
+	Submitted string `json:"submitted"`
+	Server    string `json:"server"`
+}
+
+// normalizedValues holds, by attribute, the values the server normalized on the last create or update.
+// Terraform requires the state after an apply to hold the configured value, so the normalized form is kept in the
+// private state instead, and Read only keeps the configured value while the server still holds exactly that form.
+// Any other change made outside Terraform, even one of letter case or whitespace only, shows as drift.
+type normalizedValues map[string]normalizedValue
+
+// privateState is the private state of a resource, as passed to and returned from its operations.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// loadNormalizedValues reads the values recorded by the last create or update.
+func loadNormalizedValues(ctx context.Context, private privateState) (normalizedValues, diag.Diagnostics) {
+	normalized := normalizedValues{}
+	raw, diags := private.GetKey(ctx, normalizedValuesKey)
+	if diags.HasError() || len(raw) == 0 {
+		return normalized, diags
+	}
+	if err := json.Unmarshal(raw, &normalized); err != nil {
+		diags.AddError(summaryInvalidPrivateState, fmt.Sprintf("Unable to read the normalized values from the private state: %s", err))
+	}
+	return normalized, diags
+}
+
+// add warns if the server stored a normalized form of the submitted value, and records it.
+func (n normalizedValues) add(diags *diag.Diagnostics, attribute string, submitted types.String, server string) {
+	if warnNormalized(diags, attribute, submitted, server) {
+		n[attribute] = normalizedValue{Submitted: submitted.ValueString(), Server: server}
+	}
+}
+
+// save replaces the recorded values in the private state.
+func (n normalizedValues) save(ctx context.Context, private privateState) diag.Diagnostics {
+	if len(n) == 0 {
+		return private.SetKey(ctx, normalizedValuesKey, nil)
+	}
+	raw, err := json.Marshal(n)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(summaryInvalidPrivateState, fmt.Sprintf("Unable to record the normalized values: %s", err))
+		return diags
+	}
+	return private.SetKey(ctx, normalizedValuesKey, raw)
+}
+
+// value returns the state value of an attribute read from the server: the submitted value if the server still
+// holds the normalized form it stored it in, and the server value otherwise.
+func (n normalizedValues) value(attribute, server string) types.String {
+	if recorded, ok := n[attribute]; ok && recorded.Server == server {
+		return types.StringValue(recorded.Submitted)
+	}
+	return types.StringValue(server)
+}
+
+// warnNormalized adds a warning if the server stored a normalized form of the submitted value, and reports
+// whether it did. It is only called after the value is sent, so the warning is shown once rather than on every
+// refresh.
+func warnNormalized(diags *diag.Diagnostics, attribute string, submitted types.String, server string) bool {
+	if submitted.IsNull() || submitted.IsUnknown() || submitted.ValueString() == server || !normalizedEqual(submitted.ValueString(), server) {
+		return false
+	}
+	diags.AddAttributeWarning(path.Root(attribute), summaryValueNormalized,
+		fmt.Sprintf("The server stored %q as %q. The configured value is kept in the state to avoid a permanent diff; "+
+			"update the configuration to the stored value to silence this warning.", submitted.ValueString(), server))
+	return true
+}
+
+func normalizedEqual(a, b string) bool {
+	return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
+
+// ModifyPlan checks that the server supports schedules when a health check is planned, and counts deletions and
+// replacements against the provider's max_delete_operations.
+func (r *NamespaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	if !req.Plan.Raw.IsNull() {
+		var healthCheck types.Object
+		var cluster types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("health_check"), &healthCheck)...)
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cluster"), &cluster)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !healthCheck.IsNull() {
+			resp.Diagnostics.Append(r.client.planCapability(ctx, cluster, capabilitySchedules, path.Root("health_check"))...)
 		}
 	}
+
+	// Nothing is deleted when the resource is created or updated in place.
+	if req.State.Raw.IsNull() || (!req.Plan.Raw.IsNull() && len(resp.RequiresReplace) == 0) {
+		return
+	}
+
+	var name types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.deletes.plan("temporal_namespace." + name.ValueString())...)
 }
 
-// ImportState allows existing Temporal namespaces to be imported into the Terraform state.
+// ImportState imports a namespace by name. Namespaces of a named endpoint are imported as 'name@cluster'.
 func (r *NamespaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	name, cluster := splitClusterImportID(req.ID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster"), cluster)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedKey, []byte("true"))...)
 }
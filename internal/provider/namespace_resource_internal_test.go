@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// memoryPrivateState is a privateState held in memory.
+type memoryPrivateState map[string][]byte
+
+func (m memoryPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return m[key], nil
+}
+
+func (m memoryPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(m, key)
+		return nil
+	}
+	m[key] = value
+	return nil
+}
+
+func TestNormalizedValues(t *testing.T) {
+	ctx := context.Background()
+	private := memoryPrivateState{}
+
+	var diags diag.Diagnostics
+	normalized := normalizedValues{}
+	normalized.add(&diags, "description", types.StringValue("  Orders "), "Orders")
+	normalized.add(&diags, "owner_email", types.StringValue("team@example.org"), "team@example.org")
+	if len(diags) != 1 || diags[0].Summary() != summaryValueNormalized {
+		t.Fatalf("expected one normalization warning, got %v", diags)
+	}
+	if diags := normalized.save(ctx, private); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	loaded, diags := loadNormalizedValues(ctx, private)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	tests := []struct {
+		name      string
+		attribute string
+		server    string
+		want      string
+	}{
+		{"normalized form kept", "description", "Orders", "  Orders "},
+		{"changed case shows as drift", "description", "ORDERS", "ORDERS"},
+		{"changed value shows as drift", "description", "Payments", "Payments"},
+		{"unrecorded attribute uses the server value", "owner_email", "Team@example.org", "Team@example.org"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := loaded.value(tt.attribute, tt.server).ValueString(); got != tt.want {
+				t.Errorf("value(%q, %q) = %q, want %q", tt.attribute, tt.server, got, tt.want)
+			}
+		})
+	}
+
+	// An update that is stored as submitted forgets the normalized form.
+	if diags := (normalizedValues{}).save(ctx, private); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if loaded, _ := loadNormalizedValues(ctx, private); len(loaded) != 0 {
+		t.Errorf("expected no recorded values, got %v", loaded)
+	}
+}
+
+func TestNamespaceData(t *testing.T) {
+	server := map[string]string{
+		"team":        "payments",
+		"webhook":     "https://hooks.example.com/x",
+		"out-of-band": "added in the UI",
+		managedByKey:  "terraform",
+		workspaceKey:  "prod",
+	}
+	prior := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"team":    types.StringValue("orders"),
+		"removed": types.StringValue("gone"),
+	})
+
+	tests := []struct {
+		name string
+		got  types.Map
+		want types.Map
+	}{
+		{
+			name: "managed keys only",
+			got:  managedNamespaceData(server, prior),
+			want: types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("payments")}),
+		},
+		{
+			name: "null prior stays null",
+			got:  managedNamespaceData(server, types.MapNull(types.StringType)),
+			want: types.MapNull(types.StringType),
+		},
+		{
+			name: "import takes all keys but the markers",
+			got:  importedNamespaceData(server),
+			want: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"team":        types.StringValue("payments"),
+				"webhook":     types.StringValue("https://hooks.example.com/x"),
+				"out-of-band": types.StringValue("added in the UI"),
+			}),
+		},
+		{
+			name: "import of markers only",
+			got:  importedNamespaceData(map[string]string{managedByKey: "terraform"}),
+			want: types.MapNull(types.StringType),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equal(tt.want) {
+				t.Errorf("got %s, want %s", tt.got, tt.want)
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ resource "temporal_namespace" "test" {
 					resource.TestCheckResourceAttr("temporal_namespace.test", "description", "This is a test namespace"),
 					resource.TestCheckResourceAttr("temporal_namespace.test", "owner_email", "test@example.org"),
 					resource.TestCheckResourceAttr("temporal_namespace.test", "is_global_namespace", "false"),
+					resource.TestCheckResourceAttr("temporal_namespace.test", "state", "Registered"),
 				),
 			},
 			// ImportState testing
@@ -41,11 +42,71 @@ resource "temporal_namespace" "test" {
 				name        = "test"
 				description = "This is a test namespace"
 				owner_email = "updated@example.org"
+				data = {
+					team = "platform"
+				}
+				sensitive_data = {
+					webhook = "https://hooks.example.org/secret"
+				}
 			}
 			`,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("temporal_namespace.test", "name", "test"),
 					resource.TestCheckResourceAttr("temporal_namespace.test", "owner_email", "updated@example.org"),
+					resource.TestCheckResourceAttr("temporal_namespace.test", "data.team", "platform"),
+					resource.TestCheckResourceAttr("temporal_namespace.test", "sensitive_data.webhook", "https://hooks.example.org/secret"),
+				),
+			},
+			// Health check schedule testing
+			{
+				Config: providerConfig + `
+			resource "temporal_namespace" "test" {
+				name        = "test"
+				description = "This is a test namespace"
+				owner_email = "updated@example.org"
+				health_check {
+					task_queue = "health-check"
+					interval   = "1m"
+				}
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_namespace.test", "health_check.task_queue", "health-check"),
+					resource.TestCheckResourceAttr("temporal_namespace.test", "health_check.interval", "1m"),
+					resource.TestCheckNoResourceAttr("temporal_namespace.test", "health_check.workflow_type"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNamespaceLifecycleWorkflows(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The workflows are only started, since no worker polls the task queue.
+			{
+				Config: providerConfig + `
+			resource "temporal_namespace" "lifecycle" {
+				name        = "lifecycle"
+				description = "This is a test namespace"
+				owner_email = "test@example.org"
+				on_create_workflow {
+					workflow_type = "SeedNamespace"
+					task_queue    = "lifecycle"
+					input         = [jsonencode({ tier = "gold" })]
+				}
+				pre_destroy_workflow {
+					workflow_type = "DrainNamespace"
+					task_queue    = "lifecycle"
+					workflow_id   = "drain-lifecycle"
+				}
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_namespace.lifecycle", "on_create_workflow.workflow_type", "SeedNamespace"),
+					resource.TestCheckNoResourceAttr("temporal_namespace.lifecycle", "on_create_workflow.workflow_id"),
+					resource.TestCheckResourceAttr("temporal_namespace.lifecycle", "pre_destroy_workflow.workflow_id", "drain-lifecycle"),
 				),
 			},
 		},
@@ -79,3 +140,63 @@ func TestAccNamespaceAlreadyExsits(t *testing.T) {
 		},
 	})
 }
+
+func TestAccNamespaceResourceChaos(t *testing.T) {
+	// Slow, flaky requests and split pages must not change the outcome.
+	t.Setenv("TEMPORAL_CHAOS", "latency=50ms,unavailable=0.3,truncate_pages")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "temporal_namespace" "chaos" {
+					name        = "chaos"
+					description = "This is a test namespace"
+					owner_email = "test@example.org"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_namespace.chaos", "name", "chaos"),
+					resource.TestCheckResourceAttr("temporal_namespace.chaos", "state", "Registered"),
+				),
+			},
+			{
+				ResourceName:      "temporal_namespace.chaos",
+				ImportState:       true,
+				ImportStateId:     "chaos",
+				ImportStateVerify: false,
+			},
+		},
+	})
+}
+
+func TestAccNamespaceResourceDevMode(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The dev server creates the default namespace on startup; dev_mode adopts it and keeps the archival
+			// settings it does not support in the state.
+			{
+				Config: `
+				provider "temporal" {
+					address        = "127.0.0.1:7233"
+					allow_insecure = true
+					dev_mode       = true
+				}
+
+				resource "temporal_namespace" "default" {
+					name                   = "default"
+					description            = "Adopted by Terraform"
+					owner_email            = "test@example.org"
+					history_archival_state = "Enabled"
+					history_archival_uri   = "s3://temporal-archival/history"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_namespace.default", "description", "Adopted by Terraform"),
+					resource.TestCheckResourceAttr("temporal_namespace.default", "history_archival_state", "Enabled"),
+					resource.TestCheckResourceAttr("temporal_namespace.default", "history_archival_uri", "s3://temporal-archival/history"),
+				),
+			},
+		},
+	})
+}
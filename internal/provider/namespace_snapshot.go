@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// namespaceSnapshot is written to delete_snapshot_path before a namespace is deleted. The namespace and search
+// attributes are the API responses in their JSON form, so they can be fed back to the API or the temporal CLI.
+type namespaceSnapshot struct {
+	TakenAt          time.Time       `json:"taken_at"`
+	Namespace        json.RawMessage `json:"namespace"`
+	SearchAttributes json.RawMessage `json:"search_attributes"`
+	Schedules        []string        `json:"schedules"`
+}
+
+// writeNamespaceSnapshot describes the namespace, its search attributes and schedules, and writes them to path.
+// The file is written to a temporary name first, so a failed snapshot does not replace an earlier one.
+func writeNamespaceSnapshot(ctx context.Context, client *TemporalClient, namespace, path string) error {
+	described, err := client.workflowService.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{Namespace: namespace})
+	if err != nil {
+		return fmt.Errorf("unable to describe the namespace: %w", err)
+	}
+	searchAttributes, err := client.operatorService.ListSearchAttributes(ctx, &operatorservice.ListSearchAttributesRequest{Namespace: namespace})
+	if err != nil {
+		return fmt.Errorf("unable to list the search attributes: %w", err)
+	}
+
+	snapshot := namespaceSnapshot{TakenAt: time.Now().UTC(), Schedules: []string{}}
+	if snapshot.Namespace, err = protojson.Marshal(described); err != nil {
+		return err
+	}
+	if snapshot.SearchAttributes, err = protojson.Marshal(searchAttributes); err != nil {
+		return err
+	}
+
+	var nextPageToken []byte
+	for {
+		schedules, err := client.workflowService.ListSchedules(ctx, &workflowservice.ListSchedulesRequest{
+			Namespace:       namespace,
+			MaximumPageSize: 1000,
+			NextPageToken:   nextPageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to list the schedules: %w", err)
+		}
+		for _, schedule := range schedules.GetSchedules() {
+			snapshot.Schedules = append(snapshot.Schedules, schedule.GetScheduleId())
+		}
+		nextPageToken = schedules.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	content, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".namespace-snapshot-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(content, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
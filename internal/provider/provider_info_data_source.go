@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	grpcCreds "google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ganievs/terraform-provider-temporal/internal/convert"
+	"github.com/ganievs/terraform-provider-temporal/models"
+)
+
+// Ensures that ProviderInfoDataSource fully satisfies the datasource.DataSource and
+// datasource.DataSourceWithConfigure interfaces.
+var (
+	_ datasource.DataSource              = &ProviderInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &ProviderInfoDataSource{}
+)
+
+// connectionInfo describes how the provider connects to the frontend, as resolved from the configuration and
+// the environment.
+type connectionInfo struct {
+	address           string
+	authMode          string
+	tls               bool
+	clientCertificate bool
+}
+
+// authMode names the credentials sent with every request.
+func authMode(clientID, apiKey, authToken string, kerberos, authExec bool) string {
+	switch {
+	case clientID != "":
+		return "oauth2"
+	case kerberos:
+		return "kerberos"
+	case apiKey != "":
+		return "api_key"
+	case authToken != "":
+		return "auth_token"
+	case authExec:
+		return "auth_exec"
+	default:
+		return "none"
+	}
+}
+
+// hasClientCertificate reports whether a TLS configuration presents a client certificate.
+func hasClientCertificate(config *tls.Config) bool {
+	return config != nil && (len(config.Certificates) > 0 || config.GetClientCertificate != nil)
+}
+
+// NewProviderInfoDataSource returns a new instance of the ProviderInfoDataSource.
+func NewProviderInfoDataSource() datasource.DataSource {
+	return &ProviderInfoDataSource{}
+}
+
+// ProviderInfoDataSource implements the Terraform data source interface for the connection of the provider.
+type ProviderInfoDataSource struct {
+	client     workflowservice.WorkflowServiceClient
+	connection connectionInfo
+}
+
+// Metadata sets the metadata for the Temporal provider info data source, specifically the type name.
+func (d *ProviderInfoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_provider_info"
+}
+
+// Schema defines the schema for the Temporal provider info data source.
+func (d *ProviderInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "How the provider connects to the Temporal frontend, as resolved from its configuration and environment, and what the frontend presented on the connection. " +
+			"Useful as compliance evidence and to check which cluster an aliased provider configuration reaches.",
+
+		Attributes: map[string]schema.Attribute{
+			"address": schema.StringAttribute{
+				MarkdownDescription: "Address of the frontend the provider connects to",
+				Computed:            true,
+			},
+			"auth_mode": schema.StringAttribute{
+				MarkdownDescription: "Credentials sent with every request: `none`, `oauth2`, `kerberos`, `api_key`, `auth_token` or `auth_exec`",
+				Computed:            true,
+			},
+			"tls": schema.BoolAttribute{
+				MarkdownDescription: "Whether the connection uses TLS",
+				Computed:            true,
+			},
+			"client_certificate": schema.BoolAttribute{
+				MarkdownDescription: "Whether the provider presents a TLS client certificate",
+				Computed:            true,
+			},
+			"tls_version": schema.StringAttribute{
+				MarkdownDescription: "Negotiated TLS version, e.g. `TLS 1.3`. Empty without TLS and with the HTTP transport",
+				Computed:            true,
+			},
+			"server_certificate_subject": schema.StringAttribute{
+				MarkdownDescription: "Subject of the certificate presented by the frontend. Empty without TLS and with the HTTP transport",
+				Computed:            true,
+			},
+			"server_certificate_issuer": schema.StringAttribute{
+				MarkdownDescription: "Issuer of the certificate presented by the frontend. Empty without TLS and with the HTTP transport",
+				Computed:            true,
+			},
+			"server_certificate_not_after": schema.StringAttribute{
+				MarkdownDescription: "Expiry of the certificate presented by the frontend, in RFC 3339 format. Empty without TLS and with the HTTP transport",
+				Computed:            true,
+			},
+			"server_version": schema.StringAttribute{
+				MarkdownDescription: "Temporal server version",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure sets up the provider info data source configuration.
+func (d *ProviderInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring Temporal Provider Info DataSource")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	connection, ok := req.ProviderData.(*TemporalClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			summaryDataSourceConfType,
+			fmt.Sprintf("Expected *provider.TemporalClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = connection.workflowService
+	d.connection = connection.connection
+
+	tflog.Info(ctx, "Configured Temporal Provider Info client", map[string]any{"success": true})
+}
+
+// Read reports the resolved connection settings and asks the frontend for its version, recording the
+// certificate it presents on the way.
+func (d *ProviderInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Temporal Provider Info")
+
+	var connectionPeer peer.Peer
+	info, err := d.client.GetClusterInfo(ctx, &workflowservice.GetClusterInfoRequest{}, grpc.Peer(&connectionPeer))
+	if err != nil {
+		resp.Diagnostics.AddError(summaryClientError, fmt.Sprintf("Unable to read cluster info, got error: %s", requestErrorDetail(err)))
+		return
+	}
+
+	data := &models.ProviderInfoDataSourceModel{
+		Address:                   types.StringValue(d.connection.address),
+		AuthMode:                  types.StringValue(d.connection.authMode),
+		TLS:                       types.BoolValue(d.connection.tls),
+		ClientCertificate:         types.BoolValue(d.connection.clientCertificate),
+		TLSVersion:                types.StringValue(""),
+		ServerCertificateSubject:  types.StringValue(""),
+		ServerCertificateIssuer:   types.StringValue(""),
+		ServerCertificateNotAfter: types.StringValue(""),
+		ServerVersion:             types.StringValue(info.GetServerVersion()),
+	}
+	// The HTTP transport does not report the peer of a request.
+	if tlsInfo, ok := connectionPeer.AuthInfo.(grpcCreds.TLSInfo); ok {
+		data.TLSVersion = types.StringValue(tls.VersionName(tlsInfo.State.Version))
+		if certificates := tlsInfo.State.PeerCertificates; len(certificates) > 0 {
+			data.ServerCertificateSubject = types.StringValue(certificates[0].Subject.String())
+			data.ServerCertificateIssuer = types.StringValue(certificates[0].Issuer.String())
+			data.ServerCertificateNotAfter = convert.Timestamp(timestamppb.New(certificates[0].NotAfter))
+		}
+	}
+
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
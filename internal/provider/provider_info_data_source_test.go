@@ -0,0 +1,29 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProviderInfoDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "temporal_provider_info" "current" {}
+`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.temporal_provider_info.current", "address", "127.0.0.1:7233"),
+					resource.TestCheckResourceAttr("data.temporal_provider_info.current", "auth_mode", "none"),
+					resource.TestCheckResourceAttr("data.temporal_provider_info.current", "tls", "false"),
+					resource.TestCheckResourceAttr("data.temporal_provider_info.current", "server_certificate_subject", ""),
+					resource.TestCheckResourceAttrSet("data.temporal_provider_info.current", "server_version"),
+				),
+			},
+		},
+	})
+}
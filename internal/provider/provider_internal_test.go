@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestConnectionSettingsValidate(t *testing.T) {
+	type wantError struct {
+		summary   string
+		attribute path.Path
+	}
+	tests := []struct {
+		name     string
+		settings connectionSettings
+		want     []wantError
+	}{
+		{
+			name:     "tls with api key",
+			settings: connectionSettings{tlsBlock: true, auth: map[string]bool{"api_key": true}},
+		},
+		{
+			name:     "insecure with api key",
+			settings: connectionSettings{insecure: true, auth: map[string]bool{"api_key": true}},
+			want:     []wantError{{summaryAPIKeyRequiresTLS, path.Root("api_key")}},
+		},
+		{
+			name:     "insecure with cloud namespace",
+			settings: connectionSettings{insecure: true, cloudNamespace: true},
+			want:     []wantError{{summaryCloudRequiresTLS, path.Root("cloud_namespace")}},
+		},
+		{
+			name:     "insecure with tls block",
+			settings: connectionSettings{insecure: true, tlsBlock: true},
+			want:     []wantError{{summaryRequiresTLS, path.Root("tls")}},
+		},
+		{
+			name:     "insecure skip verify without tls",
+			settings: connectionSettings{insecure: true, skipVerify: true},
+			want:     []wantError{{summaryRequiresTLS, path.Root("tls").AtName("insecure_skip_verify")}},
+		},
+		{
+			name:     "insecure skip verify in a tls block without tls",
+			settings: connectionSettings{insecure: true, tlsBlock: true, skipVerify: true},
+			want:     []wantError{{summaryRequiresTLS, path.Root("tls").AtName("insecure_skip_verify")}},
+		},
+		{
+			name:     "insecure skip verify with tls",
+			settings: connectionSettings{tlsBlock: true, skipVerify: true},
+		},
+		{
+			name:     "insecure with kerberos",
+			settings: connectionSettings{insecure: true, auth: map[string]bool{"kerberos": true}},
+			want:     []wantError{{summaryRequiresTLS, path.Root("kerberos")}},
+		},
+		{
+			name:     "cert without key",
+			settings: connectionSettings{cert: true},
+			want:     []wantError{{summaryIncompleteClientCert, path.Root("tls").AtName("key")}},
+		},
+		{
+			name:     "key without cert",
+			settings: connectionSettings{key: true},
+			want:     []wantError{{summaryIncompleteClientCert, path.Root("tls").AtName("cert")}},
+		},
+		{
+			name:     "cert without key from a credentials source",
+			settings: connectionSettings{cert: true, certPending: true},
+		},
+		{
+			name:     "cert and key",
+			settings: connectionSettings{cert: true, key: true},
+		},
+		{
+			name:     "api key with oauth2",
+			settings: connectionSettings{auth: map[string]bool{"oauth2": true, "api_key": true}},
+			want:     []wantError{{summaryConflictingAuth, path.Root("api_key")}},
+		},
+		{
+			name:     "auth exec with kerberos and auth token",
+			settings: connectionSettings{auth: map[string]bool{"auth_token": true, "kerberos": true, "auth_exec": true}},
+			want: []wantError{
+				{summaryConflictingAuth, path.Root("kerberos")},
+				{summaryConflictingAuth, path.Root("auth_exec")},
+				{summaryConflictingAuth, path.Root("auth_exec")},
+			},
+		},
+		{
+			name:     "unset auth settings",
+			settings: connectionSettings{auth: map[string]bool{"client_id": false, "api_key": true}},
+		},
+		{
+			name:     "grpc settings over http",
+			settings: connectionSettings{transport: transportHTTP, grpcOnly: map[string]bool{"endpoints": true, "connect_timeout": true, "connect_params": false}},
+			want: []wantError{
+				{summaryHTTPUnsupported, path.Root("endpoints")},
+				{summaryHTTPUnsupported, path.Root("connect_timeout")},
+			},
+		},
+		{
+			name:     "grpc settings over grpc",
+			settings: connectionSettings{transport: transportGRPC, grpcOnly: map[string]bool{"endpoints": true}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := tt.settings.validate()
+			if len(diags) != len(tt.want) {
+				t.Fatalf("validate() = %v, want %d errors", diags, len(tt.want))
+			}
+			for i, want := range tt.want {
+				got, ok := diags[i].(interface{ Path() path.Path })
+				if diags[i].Summary() != want.summary || !ok || !got.Path().Equal(want.attribute) {
+					t.Errorf("validate()[%d] = %s at %v, want %s at %s", i, diags[i].Summary(), got, want.summary, want.attribute)
+				}
+			}
+		})
+	}
+}
+
+// configureProvider runs Configure with the attributes returned by attributes, given the types of all provider
+// attributes, and leaves the others null.
+func configureProvider(t *testing.T, attributes func(types map[string]tftypes.Type) map[string]tftypes.Value) *TemporalClient {
+	t.Helper()
+	ctx := context.Background()
+	p := New("test")()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := nullAttributes(objectType)
+	for name, value := range attributes(objectType.AttributeTypes) {
+		values[name] = value
+	}
+
+	req := provider.ConfigureRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}}
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Configure() diagnostics = %v", resp.Diagnostics)
+	}
+	return resp.ResourceData.(*TemporalClient)
+}
+
+// nullAttributes returns null values for all attributes of an object type.
+func nullAttributes(objectType tftypes.Object) map[string]tftypes.Value {
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	return values
+}
+
+func TestConfigureSharesDeleteGuard(t *testing.T) {
+	client := configureProvider(t, func(types map[string]tftypes.Type) map[string]tftypes.Value {
+		endpointsType := types["endpoints"].(tftypes.Map)
+		endpointType := endpointsType.ElementType.(tftypes.Object)
+		endpoint := func(address string) tftypes.Value {
+			values := nullAttributes(endpointType)
+			values["address"] = tftypes.NewValue(tftypes.String, address)
+			values["insecure"] = tftypes.NewValue(tftypes.Bool, true)
+			return tftypes.NewValue(endpointType, values)
+		}
+		return map[string]tftypes.Value{
+			"address":               tftypes.NewValue(tftypes.String, "127.0.0.1:7233"),
+			"insecure":              tftypes.NewValue(tftypes.Bool, true),
+			"skip_health_check":     tftypes.NewValue(tftypes.Bool, true),
+			"max_delete_operations": tftypes.NewValue(tftypes.Number, 2),
+			"endpoints": tftypes.NewValue(endpointsType, map[string]tftypes.Value{
+				"standby": endpoint("127.0.0.1:7234"),
+				"archive": endpoint("127.0.0.1:7235"),
+			}),
+		}
+	})
+
+	standby, archive := client.endpoints["standby"], client.endpoints["archive"]
+	if standby == nil || archive == nil {
+		t.Fatalf("Configure() endpoints = %v, want standby and archive", client.endpoints)
+	}
+	if diags := client.deletes.plan("temporal_namespace.orders"); diags.HasError() {
+		t.Fatalf("plan() on the provider connection = %v", diags)
+	}
+	if diags := standby.deletes.plan("temporal_namespace.orders_standby"); diags.HasError() {
+		t.Fatalf("plan() on the standby endpoint = %v", diags)
+	}
+	if diags := archive.deletes.plan("temporal_namespace.orders_archive"); !diags.HasError() {
+		t.Error("plan() on the archive endpoint allowed a third deletion, want the limit shared by all endpoints")
+	}
+}
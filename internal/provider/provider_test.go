@@ -1,18 +1,21 @@
 package provider_test
 
 import (
-	"terraform-provider-temporal/internal/provider"
+	"regexp"
+	"testing"
+
+	"github.com/ganievs/terraform-provider-temporal/internal/provider"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 const (
 	providerConfig = `
 provider "temporal" {
-  host  = "127.0.0.1"
-  port  = "7233"
-  insecure = true
+  address        = "127.0.0.1:7233"
+  allow_insecure = true
 }
 `
 )
@@ -24,3 +27,52 @@ provider "temporal" {
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 	"temporal": providerserver.NewProtocol6WithError(provider.New("test")()),
 }
+
+func TestAccProviderValidateConfig(t *testing.T) {
+	const dataSource = `
+data "temporal_namespace" "default" {
+  name = "default"
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "temporal" {
+  address        = "127.0.0.1:7233"
+  allow_insecure = true
+  api_key        = "key"
+}
+` + dataSource,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("TEMPORAL-PROV-026"),
+			},
+			{
+				Config: `
+provider "temporal" {
+  address        = "127.0.0.1:7233"
+  allow_insecure = true
+  tls {
+    insecure_skip_verify = true
+  }
+}
+` + dataSource,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("TEMPORAL-PROV-068"),
+			},
+			{
+				Config: `
+provider "temporal" {
+  address = "127.0.0.1:7233"
+  tls {
+    cert_path = "client.pem"
+  }
+}
+` + dataSource,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("TEMPORAL-PROV-069"),
+			},
+		},
+	})
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/proxy"
+	"google.golang.org/grpc"
+)
+
+// parseProxyURL parses a proxy_url value. HTTP and HTTPS proxies are used with CONNECT, SOCKS5 proxies directly.
+func parseProxyURL(value string) (*url.URL, error) {
+	u, err := url.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
+	case "http", "https", "socks5", "socks5h":
+	default:
+		return nil, fmt.Errorf("unsupported scheme %q, expected http, https, socks5 or socks5h", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return nil, fmt.Errorf("missing host")
+	}
+
+	return u, nil
+}
+
+// proxyDialOption makes gRPC connect to the frontend through the proxy. Without it, gRPC already honors the
+// HTTPS_PROXY and NO_PROXY environment variables.
+func proxyDialOption(u *url.URL) (grpc.DialOption, error) {
+	if u.Scheme == "socks5" || u.Scheme == "socks5h" {
+		dialer, err := proxy.FromURL(u, &net.Dialer{})
+		if err != nil {
+			return nil, err
+		}
+		contextDialer, ok := dialer.(proxy.ContextDialer)
+		if !ok {
+			return nil, fmt.Errorf("the SOCKS5 dialer does not support contexts")
+		}
+		return grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+			return contextDialer.DialContext(ctx, "tcp", address)
+		}), nil
+	}
+
+	return grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+		return dialHTTPProxy(ctx, u, address)
+	}), nil
+}
+
+// dialHTTPProxy opens a tunnel to address with an HTTP CONNECT request to the proxy.
+func dialHTTPProxy(ctx context.Context, u *url.URL, address string) (net.Conn, error) {
+	proxyAddress := u.Host
+	if u.Port() == "" {
+		port := "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+		proxyAddress = net.JoinHostPort(u.Hostname(), port)
+	}
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", proxyAddress)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to proxy %s: %w", proxyAddress, err)
+	}
+	if u.Scheme == "https" {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: u.Hostname()})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("TLS handshake with proxy %s failed: %w", proxyAddress, err)
+		}
+		conn = tlsConn
+	}
+
+	// Cancelling the context aborts the CONNECT exchange.
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Host: address},
+		Host:   address,
+		Header: make(http.Header),
+	}
+	if u.User != nil {
+		password, _ := u.User.Password()
+		req.Header.Set("Proxy-Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(u.User.Username()+":"+password)))
+	}
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("unable to send CONNECT request to proxy %s: %w", proxyAddress, err)
+	}
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("unable to read CONNECT response from proxy %s: %w", proxyAddress, err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy %s refused to connect to %s: %s", proxyAddress, address, resp.Status)
+	}
+
+	if reader.Buffered() > 0 {
+		return &bufferedConn{Conn: conn, reader: reader}, nil
+	}
+	return conn, nil
+}
+
+// bufferedConn is a connection whose first bytes were already read into a buffer.
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ganievs/terraform-provider-temporal/models"
+)
+
+// Ensures that QueryValidateDataSource fully satisfies the datasource.DataSource and
+// datasource.DataSourceWithConfigure interfaces.
+var (
+	_ datasource.DataSource              = &QueryValidateDataSource{}
+	_ datasource.DataSourceWithConfigure = &QueryValidateDataSource{}
+)
+
+// NewQueryValidateDataSource returns a new instance of the QueryValidateDataSource.
+func NewQueryValidateDataSource() datasource.DataSource {
+	return &QueryValidateDataSource{}
+}
+
+// QueryValidateDataSource implements the Terraform data source interface for validating visibility queries.
+type QueryValidateDataSource struct {
+	client workflowservice.WorkflowServiceClient
+}
+
+// Metadata sets the metadata for the Temporal query validation data source, specifically the type name.
+func (d *QueryValidateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_query_validate"
+}
+
+// Schema defines the schema for the Temporal query validation data source.
+func (d *QueryValidateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Checks whether a visibility query is accepted by the server, without failing the plan when it is not. " +
+			"Use it in a precondition before passing the query to other resources. The query is validated by counting the matching workflow executions, " +
+			"so unknown search attributes and syntax the visibility store does not support are caught as well.",
+
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace to validate the query in, which determines the custom search attributes it may use",
+				Required:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Visibility query to validate, e.g. `WorkflowType = 'Orders' AND ExecutionStatus = 'Running'`",
+				Required:            true,
+			},
+			"valid": schema.BoolAttribute{
+				MarkdownDescription: "Whether the server accepts the query",
+				Computed:            true,
+			},
+			"error": schema.StringAttribute{
+				MarkdownDescription: "Reason the server rejected the query, empty when it is valid",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure sets up the query validation data source configuration.
+func (d *QueryValidateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring Temporal Query Validate DataSource")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	connection, ok := req.ProviderData.(*TemporalClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			summaryDataSourceConfType,
+			fmt.Sprintf("Expected *provider.TemporalClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = connection.workflowService
+
+	tflog.Info(ctx, "Configured Temporal Query Validate client", map[string]any{"success": true})
+}
+
+// Read validates the query and sets the result in the Terraform state.
+func (d *QueryValidateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Temporal Query Validate")
+
+	var data models.QueryValidateDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := d.client.CountWorkflowExecutions(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: data.Namespace.ValueString(),
+		Query:     data.Query.ValueString(),
+	})
+	switch status.Code(err) {
+	case codes.OK:
+		data.Valid = types.BoolValue(true)
+		data.Error = types.StringValue("")
+	case codes.InvalidArgument:
+		data.Valid = types.BoolValue(false)
+		data.Error = types.StringValue(status.Convert(err).Message())
+	default:
+		resp.Diagnostics.AddError(summaryClientError, fmt.Sprintf("Unable to validate the query, got error: %s", requestErrorDetail(err)))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
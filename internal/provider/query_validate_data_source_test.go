@@ -0,0 +1,36 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQueryValidateDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "temporal_query_validate" "valid" {
+	namespace = "default"
+	query     = "ExecutionStatus = 'Running'"
+}
+
+data "temporal_query_validate" "invalid" {
+	namespace = "default"
+	query     = "ExecutionStatus = "
+}
+`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.temporal_query_validate.valid", "valid", "true"),
+					resource.TestCheckResourceAttr("data.temporal_query_validate.valid", "error", ""),
+					resource.TestCheckResourceAttr("data.temporal_query_validate.invalid", "valid", "false"),
+					resource.TestCheckResourceAttrSet("data.temporal_query_validate.invalid", "error"),
+				),
+			},
+		},
+	})
+}
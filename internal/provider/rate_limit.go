@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc"
+)
+
+// rateLimiter is a token bucket that holds up to one second of requests. It is shared by every resource and
+// data source of a provider instance, which Terraform runs concurrently.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(perSecond int64) *rateLimiter {
+	return &rateLimiter{rate: float64(perSecond), tokens: float64(perSecond), last: time.Now()}
+}
+
+// wait takes a token, waiting until one is available or the context is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.rate {
+		l.tokens = l.rate
+	}
+	l.last = now
+	// Taking the token before waiting reserves it, so concurrent callers queue up in order.
+	l.tokens--
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// Give the reservation back, since the request is not sent.
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// rateLimitInterceptor holds requests back so that no more than the limiter's rate are sent per second,
+// keeping large workspaces below the frontend's rate limits instead of having their requests rejected.
+func rateLimitInterceptor(limiter *rateLimiter) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		if err := limiter.wait(ctx); err != nil {
+			return err
+		}
+		if waited := time.Since(start); waited > time.Second {
+			tflog.Debug(ctx, "Request held back by max_requests_per_second", map[string]any{"method": method, "waited": waited.String()})
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+func TestRateLimiter(t *testing.T) {
+	t.Run("a second of requests is sent at once", func(t *testing.T) {
+		limiter := newRateLimiter(50)
+		start := time.Now()
+		for i := 0; i < 50; i++ {
+			if err := limiter.wait(context.Background()); err != nil {
+				t.Fatalf("wait() error = %v", err)
+			}
+		}
+		if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+			t.Errorf("50 requests at 50 per second took %s, want no wait", elapsed)
+		}
+	})
+
+	t.Run("requests beyond the bucket wait for a token", func(t *testing.T) {
+		limiter := newRateLimiter(20)
+		for i := 0; i < 20; i++ {
+			_ = limiter.wait(context.Background())
+		}
+		start := time.Now()
+		for i := 0; i < 2; i++ {
+			if err := limiter.wait(context.Background()); err != nil {
+				t.Fatalf("wait() error = %v", err)
+			}
+		}
+		// Two tokens at 20 per second take 100ms to come back.
+		if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+			t.Errorf("2 requests past the bucket took %s, want about 100ms", elapsed)
+		}
+	})
+
+	t.Run("a cancelled wait gives its token back", func(t *testing.T) {
+		limiter := newRateLimiter(1)
+		_ = limiter.wait(context.Background())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if err := limiter.wait(ctx); !errors.Is(err, context.Canceled) {
+			t.Fatalf("wait() error = %v, want context.Canceled", err)
+		}
+
+		limiter.mu.Lock()
+		defer limiter.mu.Unlock()
+		if limiter.tokens < -0.1 {
+			t.Errorf("tokens = %f after a cancelled wait, want the reservation back", limiter.tokens)
+		}
+	})
+}
+
+func TestRateLimitInterceptor(t *testing.T) {
+	limiter := newRateLimiter(1)
+	calls := 0
+	invoker := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+		calls++
+		return nil
+	}
+	interceptor := rateLimitInterceptor(limiter)
+
+	if err := interceptor(context.Background(), describeNamespaceMethod, nil, nil, nil, invoker); err != nil || calls != 1 {
+		t.Fatalf("interceptor() = %v after %d calls, want the request sent", err, calls)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := interceptor(ctx, describeNamespaceMethod, nil, nil, nil, invoker); !errors.Is(err, context.DeadlineExceeded) || calls != 1 {
+		t.Errorf("interceptor() = %v after %d calls, want the request held back until the deadline", err, calls)
+	}
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Levels of the rpc_log_level attribute.
+const (
+	rpcLogOff   = "off"
+	rpcLogTrace = "trace"
+	rpcLogDebug = "debug"
+	rpcLogInfo  = "info"
+	rpcLogWarn  = "warn"
+)
+
+var rpcLogLevels = []string{rpcLogOff, rpcLogTrace, rpcLogDebug, rpcLogInfo, rpcLogWarn}
+
+// redacted replaces values that may hold secrets in logged payloads.
+const redacted = "REDACTED"
+
+// rpcLogFunc returns the tflog function of a log level, or nil when RPCs are not logged.
+func rpcLogFunc(level string) (func(ctx context.Context, msg string, additionalFields ...map[string]any), error) {
+	switch level {
+	case rpcLogOff:
+		return nil, nil
+	case rpcLogTrace:
+		return tflog.Trace, nil
+	case "", rpcLogDebug:
+		return tflog.Debug, nil
+	case rpcLogInfo:
+		return tflog.Info, nil
+	case rpcLogWarn:
+		return tflog.Warn, nil
+	default:
+		return nil, fmt.Errorf("unknown log level %q, expected one of %v", level, rpcLogLevels)
+	}
+}
+
+// rpcLoggingInterceptor logs the method, namespace, duration and status code of each request, and with payloads
+// the request and response in protojson, with namespace data values and payload data redacted.
+func rpcLoggingInterceptor(log func(ctx context.Context, msg string, additionalFields ...map[string]any), payloads bool) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		fields := map[string]any{
+			"method":   method,
+			"duration": time.Since(start).String(),
+			"code":     status.Code(err).String(),
+		}
+		if namespace := requestNamespace(req); namespace != "" {
+			fields["namespace"] = namespace
+		}
+		if err != nil {
+			fields["err"] = status.Convert(err).Message()
+		}
+		if payloads {
+			fields["request"] = redactedJSON(req)
+			if err == nil {
+				fields["response"] = redactedJSON(reply)
+			}
+		}
+		log(ctx, "Temporal API request", fields)
+		return err
+	}
+}
+
+// requestNamespace returns the namespace field of a request, if it has one.
+func requestNamespace(req any) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+
+	m := msg.ProtoReflect()
+	field := m.Descriptor().Fields().ByName("namespace")
+	if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() {
+		return ""
+	}
+	return m.Get(field).String()
+}
+
+// redactedJSON renders a message in protojson, with the values of namespace data and the data of payloads, such
+// as workflow inputs and signal arguments, redacted.
+func redactedJSON(v any) string {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Sprint(v)
+	}
+
+	clone := proto.Clone(msg)
+	redact(clone.ProtoReflect())
+	b, err := protojson.Marshal(clone)
+	if err != nil {
+		return err.Error()
+	}
+	return string(b)
+}
+
+// redact replaces secrets in m and the messages it holds.
+func redact(m protoreflect.Message) {
+	if m.Descriptor().FullName() == "temporal.api.common.v1.Payload" {
+		if field := m.Descriptor().Fields().ByName("data"); field != nil && m.Has(field) {
+			m.Set(field, protoreflect.ValueOfBytes([]byte(redacted)))
+		}
+		return
+	}
+
+	m.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.IsMap() && field.Name() == "data" && field.MapValue().Kind() == protoreflect.StringKind:
+			value.Map().Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+				value.Map().Set(key, protoreflect.ValueOfString(redacted))
+				return true
+			})
+		case field.IsMap() && field.MapValue().Message() != nil:
+			value.Map().Range(func(_ protoreflect.MapKey, element protoreflect.Value) bool {
+				redact(element.Message())
+				return true
+			})
+		case field.IsList() && field.Message() != nil:
+			for i := 0; i < value.List().Len(); i++ {
+				redact(value.List().Get(i).Message())
+			}
+		case field.Message() != nil && !field.IsMap() && !field.IsList():
+			redact(value.Message())
+		}
+		return true
+	})
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	namespacepb "go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/workflowservice/v1"
+)
+
+func TestRedactedJSONNamespaceData(t *testing.T) {
+	ns := &workflowservice.DescribeNamespaceResponse{
+		NamespaceInfo: &namespacepb.NamespaceInfo{
+			Name: "orders",
+			Data: map[string]string{"pagerduty_key": "s3cr3t"},
+		},
+	}
+
+	rendered := redactedJSON(ns)
+	if strings.Contains(rendered, "s3cr3t") {
+		t.Errorf("namespace data value not redacted: %s", rendered)
+	}
+	if !strings.Contains(rendered, `"pagerduty_key":"`+redacted+`"`) {
+		t.Errorf("namespace data key missing: %s", rendered)
+	}
+	if ns.GetNamespaceInfo().GetData()["pagerduty_key"] != "s3cr3t" {
+		t.Error("redaction changed the original message")
+	}
+}
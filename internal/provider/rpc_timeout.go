@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/grpc"
+)
+
+// rpcTimeoutKey is the context key of the RPC timeout a resource sets with its rpc_timeout attribute.
+type rpcTimeoutKey struct{}
+
+// rpcTimeoutAttribute returns the schema of the rpc_timeout attribute of resources.
+func rpcTimeoutAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Deadline of each API request made for this resource, e.g. `2m`. Overrides the provider's `rpc_timeout`, for operations that are known to be slow.",
+		Optional:            true,
+		Validators:          []validator.String{isDuration()},
+	}
+}
+
+// withRPCTimeout returns a context whose API requests use the resource's rpc_timeout instead of the provider's.
+func withRPCTimeout(ctx context.Context, value types.String) context.Context {
+	if value.IsNull() || value.IsUnknown() {
+		return ctx
+	}
+	// The value has already been checked by the schema validator.
+	timeout, _ := time.ParseDuration(value.ValueString())
+	return context.WithValue(ctx, rpcTimeoutKey{}, timeout)
+}
+
+// rpcTimeoutInterceptor sets a deadline on each request attempt, so a frontend that stops responding fails the
+// operation instead of stalling it. A zero timeout leaves requests without a deadline, unless a resource sets one.
+func rpcTimeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		deadline := timeout
+		if override, ok := ctx.Value(rpcTimeoutKey{}).(time.Duration); ok {
+			deadline = override
+		}
+		if deadline <= 0 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, deadline)
+		defer cancel()
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures that SDKConnectionFunction fully satisfies the function.Function interface.
+var _ function.Function = &SDKConnectionFunction{}
+
+// sdkConnectionOptions are the keys of the options argument of the sdk_connection function.
+var sdkConnectionOptions = []string{"tls", "client_cert_path", "client_key_path", "ca_path", "server_name", "api_key_env"}
+
+// sdkConnection is what the SDK snippets are rendered with.
+type sdkConnection struct {
+	Address        string
+	Namespace      string
+	TLS            bool
+	ClientCertPath string
+	ClientKeyPath  string
+	CAPath         string
+	ServerName     string
+	APIKeyEnv      string
+}
+
+// sdkConnectionTemplates render the connection of a worker for each supported SDK.
+var sdkConnectionTemplates = map[string]*template.Template{
+	"go": template.Must(template.New("go").Funcs(template.FuncMap{"quote": strconv.Quote}).Parse(`{{if .TLS}}tlsConfig := &tls.Config{ {{- if .ServerName}}ServerName: {{quote .ServerName}}{{end -}} }
+{{- if .ClientCertPath}}
+cert, err := tls.LoadX509KeyPair({{quote .ClientCertPath}}, {{quote .ClientKeyPath}})
+if err != nil {
+	log.Fatalln("Unable to load the client certificate", err)
+}
+tlsConfig.Certificates = []tls.Certificate{cert}
+{{- end}}
+{{- if .CAPath}}
+ca, err := os.ReadFile({{quote .CAPath}})
+if err != nil {
+	log.Fatalln("Unable to read the CA certificates", err)
+}
+tlsConfig.RootCAs = x509.NewCertPool()
+tlsConfig.RootCAs.AppendCertsFromPEM(ca)
+{{- end}}
+
+{{end -}}
+c, err := client.Dial(client.Options{
+	HostPort: {{quote .Address}},
+	Namespace: {{quote .Namespace}},
+{{- if .TLS}}
+	ConnectionOptions: client.ConnectionOptions{TLS: tlsConfig},
+{{- end}}
+{{- if .APIKeyEnv}}
+	Credentials: client.NewAPIKeyStaticCredentials(os.Getenv({{quote .APIKeyEnv}})),
+{{- end}}
+})
+if err != nil {
+	log.Fatalln("Unable to create the Temporal client", err)
+}
+defer c.Close()
+`)),
+	"typescript": template.Must(template.New("typescript").Funcs(template.FuncMap{"quote": jsonQuote}).Parse(`import { NativeConnection } from '@temporalio/worker';
+{{- if or .ClientCertPath .CAPath}}
+import fs from 'fs';
+{{- end}}
+
+export const namespace = {{quote .Namespace}};
+
+export const connection = await NativeConnection.connect({
+  address: {{quote .Address}},
+{{- if and .TLS (or .ServerName .CAPath .ClientCertPath)}}
+  tls: {
+{{- if .ServerName}}
+    serverNameOverride: {{quote .ServerName}},
+{{- end}}
+{{- if .CAPath}}
+    serverRootCACertificate: fs.readFileSync({{quote .CAPath}}),
+{{- end}}
+{{- if .ClientCertPath}}
+    clientCertPair: {
+      crt: fs.readFileSync({{quote .ClientCertPath}}),
+      key: fs.readFileSync({{quote .ClientKeyPath}}),
+    },
+{{- end}}
+  },
+{{- else if .TLS}}
+  tls: true,
+{{- end}}
+{{- if .APIKeyEnv}}
+  apiKey: process.env[{{quote .APIKeyEnv}}],
+{{- end}}
+});
+`)),
+}
+
+// jsonQuote quotes a string for TypeScript. JSON strings are valid string literals there.
+func jsonQuote(s string) string {
+	quoted, _ := json.Marshal(s)
+	return string(quoted)
+}
+
+// NewSDKConnectionFunction returns a new instance of the SDKConnectionFunction.
+func NewSDKConnectionFunction() function.Function {
+	return &SDKConnectionFunction{}
+}
+
+// SDKConnectionFunction implements the Terraform function that renders the connection of an SDK worker.
+type SDKConnectionFunction struct{}
+
+// Metadata sets the name of the function.
+func (f *SDKConnectionFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "sdk_connection"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *SDKConnectionFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Renders the connection of a Temporal SDK worker",
+		MarkdownDescription: "Renders ready-to-use code that connects a Go or TypeScript SDK worker, so application configuration does not drift from the infrastructure. " +
+			"Functions cannot read the provider configuration; pass the `address` and `tls` attributes of the `temporal_provider_info` data source to render the connection the provider uses. " +
+			"Secrets are never rendered: the API key is read from the environment variable named by `api_key_env`. Requires Terraform 1.8 or later.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "language",
+				MarkdownDescription: "Language of the SDK, `go` or `typescript`",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.OneOf("go", "typescript"),
+				},
+			},
+			function.StringParameter{
+				Name:                "address",
+				MarkdownDescription: "Address of the frontend (host:port)",
+			},
+			function.StringParameter{
+				Name:                "namespace",
+				MarkdownDescription: "Namespace the worker polls",
+			},
+			function.MapParameter{
+				Name:        "options",
+				ElementType: types.StringType,
+				MarkdownDescription: "TLS and credential settings, all optional: `tls` (`true` or `false`), `client_cert_path`, `client_key_path`, `ca_path`, `server_name` and `api_key_env`. " +
+					"TLS is used when `tls` is `true`, or when it is not set and any other option is.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run renders the snippet.
+func (f *SDKConnectionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var language, address, namespace string
+	var options map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &language, &address, &namespace, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	connection, err := newSDKConnection(address, namespace, options)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(3, err.Error())
+		return
+	}
+
+	var snippet bytes.Buffer
+	if err := sdkConnectionTemplates[language].Execute(&snippet, connection); err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to render the %s connection: %s", language, err))
+		return
+	}
+	rendered := snippet.Bytes()
+	if language == "go" {
+		if rendered, err = format.Source(rendered); err != nil {
+			resp.Error = function.NewFuncError(fmt.Sprintf("Unable to format the go connection: %s. Please report this issue to the provider developers.", err))
+			return
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(rendered)))
+}
+
+// newSDKConnection reads the options argument of the sdk_connection function.
+func newSDKConnection(address, namespace string, options map[string]string) (sdkConnection, error) {
+	for key := range options {
+		if !slices.Contains(sdkConnectionOptions, key) {
+			known := append([]string(nil), sdkConnectionOptions...)
+			sort.Strings(known)
+			return sdkConnection{}, fmt.Errorf("unknown option %q, expected one of: %s", key, strings.Join(known, ", "))
+		}
+	}
+
+	connection := sdkConnection{
+		Address:        address,
+		Namespace:      namespace,
+		ClientCertPath: options["client_cert_path"],
+		ClientKeyPath:  options["client_key_path"],
+		CAPath:         options["ca_path"],
+		ServerName:     options["server_name"],
+		APIKeyEnv:      options["api_key_env"],
+	}
+	if (connection.ClientCertPath == "") != (connection.ClientKeyPath == "") {
+		return sdkConnection{}, fmt.Errorf("client_cert_path and client_key_path must be set together")
+	}
+
+	connection.TLS = connection.ClientCertPath != "" || connection.CAPath != "" || connection.ServerName != "" || connection.APIKeyEnv != ""
+	if value, ok := options["tls"]; ok {
+		var err error
+		if connection.TLS, err = strconv.ParseBool(value); err != nil {
+			return sdkConnection{}, fmt.Errorf("option tls must be true or false, got %q", value)
+		}
+	}
+	if !connection.TLS && (connection.ClientCertPath != "" || connection.CAPath != "" || connection.ServerName != "") {
+		return sdkConnection{}, fmt.Errorf("client_cert_path, ca_path and server_name require tls")
+	}
+	return connection, nil
+}
@@ -0,0 +1,46 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccSDKConnectionFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "go" {
+					value = provider::temporal::sdk_connection("go", "temporal.example.com:7233", "orders", {
+						api_key_env = "TEMPORAL_API_KEY"
+					})
+				}
+
+				output "typescript" {
+					value = provider::temporal::sdk_connection("typescript", "127.0.0.1:7233", "default", {})
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchOutput("go", regexp.MustCompile(`HostPort:\s+"temporal.example.com:7233"`)),
+					resource.TestMatchOutput("go", regexp.MustCompile(`client.NewAPIKeyStaticCredentials\(os.Getenv\("TEMPORAL_API_KEY"\)\)`)),
+					resource.TestMatchOutput("typescript", regexp.MustCompile(`address: "127.0.0.1:7233",\n}\);`)),
+				),
+			},
+			{
+				Config: `
+				output "invalid" {
+					value = provider::temporal::sdk_connection("go", "127.0.0.1:7233", "default", {
+						client_cert_path = "/etc/temporal/client.pem"
+					})
+				}`,
+				ExpectError: regexp.MustCompile("client_cert_path and client_key_path must be set together"),
+			},
+		},
+	})
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/operatorservice/v1"
-	"google.golang.org/grpc"
+
+	"github.com/ganievs/terraform-provider-temporal/internal/convert"
+	"github.com/ganievs/terraform-provider-temporal/models"
 )
 
 // Ensures that SearchAttributeDataSource fully satisfies the datasource.DataSource and
@@ -28,14 +30,8 @@ func NewSearchAttributeDataSource() datasource.DataSource {
 
 // SearchAttributeDataSource implements the Terraform data source interface for Temporal SearchAttributes.
 type SearchAttributeDataSource struct {
-	client operatorservice.OperatorServiceClient
-}
-
-// SearchAttributeDataSourceModel defines the structure for the data source's configuration and read data.
-type SearchAttributeDataSourceModel struct {
-	Name      types.String `tfsdk:"name"`
-	Type      types.String `tfsdk:"type"`
-	Namespace types.String `tfsdk:"namespace"`
+	client    operatorservice.OperatorServiceClient
+	namespace string
 }
 
 // Metadata sets the metadata for the Temporal SearchAttribute data source, specifically the type name.
@@ -59,7 +55,7 @@ func (d *SearchAttributeDataSource) Schema(ctx context.Context, req datasource.S
 				Computed:            true,
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace with which the Search Attribute is associated. If this is not provided, 'default' will be used",
+				MarkdownDescription: "Namespace with which the Search Attribute is associated. If this is not provided, the provider's `namespace` or 'default' will be used",
 				Optional:            true,
 			},
 		},
@@ -75,17 +71,17 @@ func (d *SearchAttributeDataSource) Configure(ctx context.Context, req datasourc
 		return
 	}
 
-	connection, ok := req.ProviderData.(grpc.ClientConnInterface)
+	connection, ok := req.ProviderData.(*TemporalClient)
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected grpc.ClientConnInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			summaryDataSourceConfType,
+			fmt.Sprintf("Expected *provider.TemporalClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	client := operatorservice.NewOperatorServiceClient(connection)
-	d.client = client
+	d.client = connection.operatorService
+	d.namespace = connection.defaultNamespace()
 
 	tflog.Info(ctx, "Configured Temporal Search Attribute client", map[string]any{"success": true})
 }
@@ -110,9 +106,9 @@ func (d *SearchAttributeDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	// If the user has not provided a namespace for the data source, use 'default'
+	// If the user has not provided a namespace for the data source, use the provider's default namespace
 	if namespace.IsNull() {
-		namespace = types.StringValue("default")
+		namespace = types.StringValue(d.namespace)
 	}
 
 	request := &operatorservice.ListSearchAttributesRequest{
@@ -122,7 +118,7 @@ func (d *SearchAttributeDataSource) Read(ctx context.Context, req datasource.Rea
 	// Calling API for existing attribute details
 	searchAttributes, err := d.client.ListSearchAttributes(ctx, request)
 	if err != nil {
-		resp.Diagnostics.AddError("API Client Error", fmt.Sprintf("Unable to read SearchAttribute: %s", err))
+		resp.Diagnostics.AddError(summaryClientError, fmt.Sprintf("Unable to read SearchAttribute: %s", requestErrorDetail(err)))
 		return
 	}
 
@@ -130,15 +126,15 @@ func (d *SearchAttributeDataSource) Read(ctx context.Context, req datasource.Rea
 	var found bool
 	if attributeType, found = searchAttributes.GetCustomAttributes()[name]; !found {
 		if attributeType, found = searchAttributes.GetSystemAttributes()[name]; !found {
-			resp.Diagnostics.AddError("Not Found", fmt.Sprintf("SearchAttribute '%s' not found in namespace '%s'", name, namespace))
+			resp.Diagnostics.AddError(summaryNotFound, fmt.Sprintf("SearchAttribute '%s' not found in namespace '%s'", name, namespace))
 			return
 		}
 	}
 
 	// Prepare the data to be set in the Terraform state
-	data := &SearchAttributeDataSourceModel{
+	data := &models.SearchAttributeDataSourceModel{
 		Name:      types.StringValue(name),
-		Type:      types.StringValue(attributeType.String()),
+		Type:      convert.Enum(attributeType),
 		Namespace: namespace,
 	}
 
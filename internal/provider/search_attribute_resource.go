@@ -4,12 +4,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -17,40 +16,19 @@ import (
 	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/operatorservice/v1"
 	"go.temporal.io/api/serviceerror"
-	"google.golang.org/grpc"
+
+	"github.com/ganievs/terraform-provider-temporal/internal/convert"
+	"github.com/ganievs/terraform-provider-temporal/models"
+	"github.com/ganievs/terraform-provider-temporal/temporalclient"
 )
 
 var (
 	_ resource.Resource                = &SearchAttributeResource{}
 	_ resource.ResourceWithConfigure   = &SearchAttributeResource{}
 	_ resource.ResourceWithImportState = &SearchAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &SearchAttributeResource{}
 )
 
-// AwaitAddSearchAttributes waits for the completion of AddSearchAttributesRequest using ListSearchAttributes.
-func AwaitAddSearchAttributes(ctx context.Context, operatorClient operatorservice.OperatorServiceClient, data SearchAttributeResourceModel) error {
-	ticker := time.NewTicker(1 * time.Second)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			// Verify attribute creation
-			attributes, err := operatorClient.ListSearchAttributes(ctx, &operatorservice.ListSearchAttributesRequest{
-				Namespace: data.Namespace.ValueString(),
-			})
-			if err != nil {
-				return err
-			}
-
-			if _, ok := attributes.CustomAttributes[data.Name.ValueString()]; ok {
-				return nil
-			}
-		case <-ctx.Done():
-			return ctx.Err()
-		}
-	}
-}
-
 // NewSearchAttributeResource creates a new instance of SearchAttributeResource.
 func NewSearchAttributeResource() resource.Resource {
 	return &SearchAttributeResource{}
@@ -58,14 +36,7 @@ func NewSearchAttributeResource() resource.Resource {
 
 // SearchAttributeResource - a Temporal search attribute resource implementation.
 type SearchAttributeResource struct {
-	client grpc.ClientConnInterface
-}
-
-// SearchAttributeResourceModel defines the data schema for a Temporal search attribute resource.
-type SearchAttributeResourceModel struct {
-	Name      types.String `tfsdk:"name"`
-	Type      types.String `tfsdk:"type"`
-	Namespace types.String `tfsdk:"namespace"`
+	client *TemporalClient
 }
 
 // Metadata sets the metadata for the  search attribute resource, specifically the type name.
@@ -92,11 +63,12 @@ func (r *SearchAttributeResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace with which the Search Attribute is associated",
+				MarkdownDescription: "Namespace with which the Search Attribute is associated. Defaults to the provider's `namespace`, or `default`.",
 				Optional:            true,
-				Default:             stringdefault.StaticString("default"),
 				Computed:            true,
 			},
+			"cluster":     clusterAttribute(),
+			"rpc_timeout": rpcTimeoutAttribute(),
 		},
 	}
 }
@@ -110,11 +82,11 @@ func (r *SearchAttributeResource) Configure(ctx context.Context, req resource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(grpc.ClientConnInterface)
+	client, ok := req.ProviderData.(*TemporalClient)
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected grpc.ClientConnInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			summaryResourceConfType,
+			fmt.Sprintf("Expected *provider.TemporalClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -125,9 +97,7 @@ func (r *SearchAttributeResource) Configure(ctx context.Context, req resource.Co
 
 // Create is responsible for creating a new search attribute in Temporal.
 func (r *SearchAttributeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data SearchAttributeResourceModel
-
-	client := operatorservice.NewOperatorServiceClient(r.client)
+	var data models.SearchAttributeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -136,6 +106,18 @@ func (r *SearchAttributeResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx, done := r.client.timeOperation(ctx, "create", "temporal_search_attribute."+data.Namespace.ValueString()+":"+data.Name.ValueString())
+	defer done()
+	ctx = withRPCTimeout(ctx, data.RPCTimeout)
+	ctx = withDevMode(ctx, r.client.devMode)
+
+	cluster, diags := r.client.cluster(data.Cluster)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := cluster.operatorService
+
 	// Check if the attribute already exists in the custom attributes
 	// The API indicates that this should be handled by the AddSearchAttributes() method, but docs seem to be out of date
 	// Support thread: https://temporalio.slack.com/archives/CTDTU3J4T/p1721255485197359
@@ -144,34 +126,30 @@ func (r *SearchAttributeResource) Create(ctx context.Context, req resource.Creat
 		Namespace: data.Namespace.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", "Failed to list existing search attributes: "+err.Error())
+		resp.Diagnostics.AddError(summaryClientError, "Failed to list existing search attributes: "+requestErrorDetail(err))
 		return
 	}
 	if _, exists := existingAttrs.CustomAttributes[data.Name.ValueString()]; exists {
-		resp.Diagnostics.AddError("Already Exists", "Search attribute with the provided name already exists and cannot be created again.")
+		resp.Diagnostics.AddError(summaryAlreadyExists, "Search attribute with the provided name already exists and cannot be created again.")
 		return
 	}
 
-	// Create attribute
-	indexedValueType, _ := enums.IndexedValueTypeFromString(data.Type.ValueString())
+	// Create attribute. Attributes created for the same namespace in parallel are sent in a single request.
+	indexedValueType, _ := convert.EnumValue(data.Type, enums.IndexedValueTypeFromString)
 
-	request := &operatorservice.AddSearchAttributesRequest{
-		Namespace:        data.Namespace.ValueString(),
-		SearchAttributes: map[string]enums.IndexedValueType{data.Name.ValueString(): indexedValueType},
-	}
-	_, err = client.AddSearchAttributes(ctx, request)
+	err = cluster.searchAttributes.Add(ctx, data.Namespace.ValueString(), data.Name.ValueString(), indexedValueType)
 	if err != nil {
 		if _, ok := err.(*serviceerror.AlreadyExists); ok {
-			resp.Diagnostics.AddError("Request Error", "Search attribute with that name is already registered: "+err.Error())
+			resp.Diagnostics.AddError(summaryRequestError, "Search attribute with that name is already registered: "+err.Error())
 			return
 		}
-		resp.Diagnostics.AddError("Request error", "Search attribute creation failed: "+err.Error())
+		resp.Diagnostics.AddError(summaryRequestError, "Search attribute creation failed: "+requestErrorDetail(err))
 		return
 	}
 
-	err = AwaitAddSearchAttributes(ctx, client, data)
+	err = temporalclient.AwaitSearchAttribute(ctx, client, data.Namespace.ValueString(), data.Name.ValueString(), propagationInterval(ctx))
 	if err != nil {
-		resp.Diagnostics.AddError("Request Error", "Error awaiting results: "+err.Error())
+		resp.Diagnostics.AddError(summaryRequestError, "Error awaiting results: "+err.Error())
 		return
 	}
 
@@ -187,9 +165,7 @@ func (r *SearchAttributeResource) Create(ctx context.Context, req resource.Creat
 // Read is responsible for reading the current state of a Temporal search attribute.
 // It fetches the current configuration of the search attribute and updates the Terraform state.
 func (r *SearchAttributeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state SearchAttributeResourceModel
-
-	client := operatorservice.NewOperatorServiceClient(r.client)
+	var state models.SearchAttributeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -197,12 +173,23 @@ func (r *SearchAttributeResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	ctx, done := r.client.timeOperation(ctx, "read", "temporal_search_attribute."+state.Namespace.ValueString()+":"+state.Name.ValueString())
+	defer done()
+	ctx = withRPCTimeout(ctx, state.RPCTimeout)
+
+	cluster, diags := r.client.cluster(state.Cluster)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := cluster.operatorService
+
 	attributes, err := client.ListSearchAttributes(ctx, &operatorservice.ListSearchAttributesRequest{
 		Namespace: state.Namespace.ValueString(),
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read search attribute info, got error: %s", err))
+		resp.Diagnostics.AddError(summaryClientError, fmt.Sprintf("Unable to read search attribute info, got error: %s", requestErrorDetail(err)))
 		return
 	}
 
@@ -215,10 +202,12 @@ func (r *SearchAttributeResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	data := &SearchAttributeResourceModel{
-		Name:      state.Name,
-		Namespace: state.Namespace,
-		Type:      types.StringValue(attr.String()),
+	data := &models.SearchAttributeResourceModel{
+		Name:       state.Name,
+		Namespace:  state.Namespace,
+		Type:       convert.Enum(attr),
+		Cluster:    state.Cluster,
+		RPCTimeout: state.RPCTimeout,
 	}
 
 	// Set refreshed state
@@ -239,9 +228,7 @@ func (r *SearchAttributeResource) Update(ctx context.Context, req resource.Updat
 
 // Delete removes a Temporal search attribute from both Temporal and the Terraform state.
 func (r *SearchAttributeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data SearchAttributeResourceModel
-
-	client := operatorservice.NewOperatorServiceClient(r.client)
+	var data models.SearchAttributeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -249,6 +236,17 @@ func (r *SearchAttributeResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	ctx, done := r.client.timeOperation(ctx, "delete", "temporal_search_attribute."+data.Namespace.ValueString()+":"+data.Name.ValueString())
+	defer done()
+	ctx = withRPCTimeout(ctx, data.RPCTimeout)
+
+	cluster, diags := r.client.cluster(data.Cluster)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := cluster.operatorService
+
 	// Delete request
 	request := &operatorservice.RemoveSearchAttributesRequest{
 		Namespace:        data.Namespace.ValueString(),
@@ -259,31 +257,70 @@ func (r *SearchAttributeResource) Delete(ctx context.Context, req resource.Delet
 
 	if err != nil {
 		if _, ok := err.(*serviceerror.NotFound); ok {
-			resp.Diagnostics.AddError("Request error", "Search attribute not found: "+err.Error())
+			resp.Diagnostics.AddError(summaryRequestError, "Search attribute not found: "+err.Error())
 			return
 		}
-		resp.Diagnostics.AddError("Request error", "Unable to delete search attribute "+err.Error())
+		resp.Diagnostics.AddError(summaryRequestError, "Unable to delete search attribute "+requestErrorDetail(err))
 		return
 	}
 
 	tflog.Info(ctx, fmt.Sprintf("Successfully deleted search attribute: %s", data.Name.ValueString()))
 }
 
+// ModifyPlan counts deletions and replacements against the provider's max_delete_operations.
+func (r *SearchAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Search attributes without a namespace are created in the provider's default namespace, and stay in the
+	// namespace they were created in when the default changes.
+	if r.client != nil && !req.Plan.Raw.IsNull() {
+		var configured types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("namespace"), &configured)...)
+		if configured.IsNull() {
+			namespace := types.StringValue(r.client.defaultNamespace())
+			if !req.State.Raw.IsNull() {
+				resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("namespace"), &namespace)...)
+			}
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("namespace"), namespace)...)
+		}
+	}
+
+	// Nothing is deleted when the resource is created or updated in place.
+	if r.client == nil || req.State.Raw.IsNull() || (!req.Plan.Raw.IsNull() && len(resp.RequiresReplace) == 0) {
+		return
+	}
+
+	var name, namespace types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("namespace"), &namespace)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.deletes.plan("temporal_search_attribute." + namespace.ValueString() + ":" + name.ValueString())...)
+}
+
 // ImportState allows existing Temporal search attributes to be imported into the Terraform state.
 // Importing system attributes is not supported.
 func (r *SearchAttributeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 
 	// Expected request ID format is either 'namespace:search_attribute_name' or 'search_attribute_name'
 	// Ex: 'default:CustomBool' or 'customBool'
-	// If no namespace is provided, 'default' will be used
+	// If no namespace is provided, the provider's default namespace will be used
+	// Attributes of a named endpoint have an '@cluster' suffix, Ex: 'default:CustomBool@standby'
 
 	var namespace, attributeName string
 
-	idTokens := strings.Split(req.ID, ":")
+	id, clusterName := splitClusterImportID(req.ID)
+	cluster, diags := r.client.cluster(clusterName)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idTokens := strings.Split(id, ":")
 	switch len(idTokens) {
 	case 1:
-		// One part: Attribute name with default namespace ('CustomBool')
-		namespace = "default"
+		// One part: Attribute name in the default namespace ('CustomBool')
+		namespace = cluster.defaultNamespace()
 		attributeName = idTokens[0]
 	case 2:
 		// Two parts: the first is namespace, second is attribute name ('default:CustomBool')
@@ -291,19 +328,19 @@ func (r *SearchAttributeResource) ImportState(ctx context.Context, req resource.
 		attributeName = idTokens[1]
 	default:
 		// If neither, return an error
-		resp.Diagnostics.AddError("Invalid ID format", "Expected 'namespace:search_attribute_name' or just 'search_attribute_name'.")
+		resp.Diagnostics.AddError(summaryInvalidImportID, "Expected 'namespace:search_attribute_name' or just 'search_attribute_name'.")
 		return
 	}
 
 	// Fetch the search attribute details
-	client := operatorservice.NewOperatorServiceClient(r.client)
+	client := cluster.operatorService
 	attrRequest := &operatorservice.ListSearchAttributesRequest{
 		Namespace: namespace,
 	}
 
 	attributes, err := client.ListSearchAttributes(ctx, attrRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read search attribute info, got error: %s", err))
+		resp.Diagnostics.AddError(summaryClientError, fmt.Sprintf("Unable to read search attribute info, got error: %s", requestErrorDetail(err)))
 		return
 	}
 
@@ -312,15 +349,16 @@ func (r *SearchAttributeResource) ImportState(ctx context.Context, req resource.
 	var found bool
 
 	if attributeType, found = attributes.GetCustomAttributes()[attributeName]; !found {
-		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Custom Search Attribute '%s' not found in namespace '%s'", attributeName, namespace))
+		resp.Diagnostics.AddError(summaryNotFound, fmt.Sprintf("Custom Search Attribute '%s' not found in namespace '%s'", attributeName, namespace))
 		return
 	}
 
 	// Set the ID and other attributes needed for managing the resource
-	diags := resp.State.Set(ctx, &SearchAttributeResourceModel{
+	diags = resp.State.Set(ctx, &models.SearchAttributeResourceModel{
 		Name:      types.StringValue(attributeName),
 		Namespace: types.StringValue(namespace),
-		Type:      types.StringValue(attributeType.String()),
+		Type:      convert.Enum(attributeType),
+		Cluster:   clusterName,
 	})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
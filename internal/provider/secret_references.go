@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// secretReferenceDescription is appended to the description of the attributes that accept secret references.
+const secretReferenceDescription = " Can also be a reference to a secret that is read while the provider is configured, so the value never passes through Terraform variables or state: " +
+	"`vault://PATH#KEY` for a key of a Vault secret, read with `VAULT_ADDR` and `VAULT_TOKEN`, " +
+	"or the ARN of an AWS Secrets Manager secret, optionally followed by `#KEY` for a key of a JSON secret."
+
+const vaultReferencePrefix = "vault://"
+
+// isSecretReference reports whether value refers to a secret rather than holding it.
+func isSecretReference(value string) bool {
+	return strings.HasPrefix(value, vaultReferencePrefix) ||
+		(strings.HasPrefix(value, "arn:") && strings.Contains(value, ":secretsmanager:"))
+}
+
+// resolveSecretReference returns the secret value refers to, or value itself when it is not a reference.
+func resolveSecretReference(ctx context.Context, value string) (string, error) {
+	if !isSecretReference(value) {
+		return value, nil
+	}
+
+	reference, key, _ := strings.Cut(value, "#")
+	if strings.HasPrefix(reference, vaultReferencePrefix) {
+		return vaultSecretValue(ctx, strings.TrimPrefix(reference, vaultReferencePrefix), key)
+	}
+
+	secret, err := awsSecretValue(ctx, reference)
+	if err != nil || key == "" {
+		return secret, err
+	}
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(secret), &fields); err != nil {
+		return "", fmt.Errorf("the secret %s is not a JSON object, remove #%s to use it as a whole: %w", reference, key, err)
+	}
+	return secretField(fields, reference, key)
+}
+
+// resolveSecretReferences resolves the secret references of the PEM values of the TLS settings at base.
+func (m *tlsModel) resolveSecretReferences(ctx context.Context, base path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for name, value := range map[string]*types.String{"cert": &m.Cert, "key": &m.Key, "ca": &m.CA} {
+		if !isSecretReference(value.ValueString()) {
+			continue
+		}
+		resolved, err := resolveSecretReference(ctx, value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(base.AtName(name), summarySecretReference, err.Error())
+			continue
+		}
+		*value = types.StringValue(resolved)
+	}
+	return diags
+}
+
+// vaultSecretValue reads a key of a Vault secret. Paths on KV version 2 mounts may be given like to the vault kv
+// commands, e.g. secret/temporal for secret/data/temporal. A key is only optional for secrets with a single key.
+func vaultSecretValue(ctx context.Context, secretPath, key string) (string, error) {
+	address := strings.TrimSuffix(os.Getenv("VAULT_ADDR"), "/")
+	if address == "" {
+		return "", errors.New("VAULT_ADDR must be set to read secrets from Vault")
+	}
+	token := os.Getenv("VAULT_TOKEN")
+	if token == "" {
+		// The token helper of the vault CLI stores the token of vault login here.
+		if home, err := os.UserHomeDir(); err == nil {
+			if b, err := os.ReadFile(filepath.Join(home, ".vault-token")); err == nil {
+				token = strings.TrimSpace(string(b))
+			}
+		}
+	}
+	if token == "" {
+		return "", errors.New("VAULT_TOKEN must be set, or a token stored with vault login, to read secrets from Vault")
+	}
+
+	get := func(apiPath string, out any) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, address+"/v1/"+apiPath, nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("X-Vault-Token", token)
+		if namespace := os.Getenv("VAULT_NAMESPACE"); namespace != "" {
+			req.Header.Set("X-Vault-Namespace", namespace)
+		}
+		return doSecretRequest(req, out)
+	}
+
+	// Tokens that cannot look up the mount read the path as given.
+	secretPath = strings.Trim(secretPath, "/")
+	var mount struct {
+		Data struct {
+			Path    string `json:"path"`
+			Options struct {
+				Version string `json:"version"`
+			} `json:"options"`
+		} `json:"data"`
+	}
+	kv2 := false
+	if err := get("sys/internal/ui/mounts/"+secretPath, &mount); err == nil && mount.Data.Options.Version == "2" {
+		kv2 = true
+		if rest := strings.TrimPrefix(secretPath, mount.Data.Path); !strings.HasPrefix(rest, "data/") {
+			secretPath = mount.Data.Path + "data/" + rest
+		}
+	}
+
+	var secret struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := get(secretPath, &secret); err != nil {
+		return "", fmt.Errorf("unable to read the Vault secret %s: %w", secretPath, err)
+	}
+	fields := secret.Data
+	if kv2 {
+		fields, _ = secret.Data["data"].(map[string]any)
+	}
+	return secretField(fields, secretPath, key)
+}
+
+// secretField returns the string value of key in the fields of a secret, or its only field when key is empty.
+func secretField(fields map[string]any, secret, key string) (string, error) {
+	if key == "" {
+		if len(fields) != 1 {
+			names := make([]string, 0, len(fields))
+			for name := range fields {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			return "", fmt.Errorf("the secret %s has the keys %v, add #KEY to the reference to pick one", secret, names)
+		}
+		for name := range fields {
+			key = name
+		}
+	}
+
+	value, ok := fields[key]
+	if !ok {
+		return "", fmt.Errorf("the secret %s has no key %s", secret, key)
+	}
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("the key %s of the secret %s is not a string", key, secret)
+	}
+	return s, nil
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsSecretReference(t *testing.T) {
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{"vault://secret/temporal#api_key", true},
+		{"arn:aws:secretsmanager:eu-west-1:123456789012:secret:temporal-AbCdEf", true},
+		{"arn:aws:iam::123456789012:role/temporal", false},
+		{"plain-api-key", false},
+		{"", false},
+		{"-----BEGIN CERTIFICATE-----", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := isSecretReference(tt.value); got != tt.want {
+				t.Errorf("isSecretReference(%q) = %t, want %t", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSecretField(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  map[string]any
+		key     string
+		want    string
+		wantErr string
+	}{
+		{name: "key", fields: map[string]any{"api_key": "k", "other": "o"}, key: "api_key", want: "k"},
+		{name: "only field", fields: map[string]any{"api_key": "k"}, want: "k"},
+		{name: "several fields without key", fields: map[string]any{"b": "1", "a": "2"}, wantErr: "[a b]"},
+		{name: "missing key", fields: map[string]any{"api_key": "k"}, key: "token", wantErr: "has no key token"},
+		{name: "not a string", fields: map[string]any{"port": 7233.0}, key: "port", wantErr: "is not a string"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := secretField(tt.fields, "secret/temporal", tt.key)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("secretField() error = %v, want it to mention %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("secretField() = %q, %v, want %q", got, err, tt.want)
+			}
+		})
+	}
+}
+
+// fakeVault serves the secrets of a KV version 1 mount at kv/ and a KV version 2 mount at secret/.
+func fakeVault(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "token" {
+			http.Error(w, `{"errors":["permission denied"]}`, http.StatusForbidden)
+			return
+		}
+		var body any
+		switch r.URL.Path {
+		case "/v1/sys/internal/ui/mounts/secret/temporal", "/v1/sys/internal/ui/mounts/secret/data/temporal":
+			body = map[string]any{"data": map[string]any{"path": "secret/", "options": map[string]any{"version": "2"}}}
+		case "/v1/sys/internal/ui/mounts/kv/temporal":
+			body = map[string]any{"data": map[string]any{"path": "kv/", "options": map[string]any{"version": "1"}}}
+		case "/v1/secret/data/temporal":
+			if r.Header.Get("X-Vault-Namespace") != "" && r.Header.Get("X-Vault-Namespace") != "team" {
+				http.NotFound(w, r)
+				return
+			}
+			body = map[string]any{"data": map[string]any{"data": map[string]any{"api_key": "v2-key", "auth_token": "v2-token"}}}
+		case "/v1/kv/temporal":
+			body = map[string]any{"data": map[string]any{"api_key": "v1-key"}}
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestResolveSecretReference(t *testing.T) {
+	server := fakeVault(t)
+	t.Setenv("VAULT_ADDR", server.URL+"/")
+	t.Setenv("VAULT_TOKEN", "token")
+
+	tests := []struct {
+		name      string
+		value     string
+		namespace string
+		want      string
+		wantErr   string
+	}{
+		{name: "plain value", value: "plain-api-key", want: "plain-api-key"},
+		{name: "kv2 path as given to the vault kv commands", value: "vault://secret/temporal#api_key", want: "v2-key"},
+		{name: "kv2 api path", value: "vault://secret/data/temporal#auth_token", want: "v2-token"},
+		{name: "kv2 in a namespace", value: "vault://secret/temporal#api_key", namespace: "team", want: "v2-key"},
+		{name: "kv1 single key", value: "vault:///kv/temporal/", want: "v1-key"},
+		{name: "kv2 without key", value: "vault://secret/temporal", wantErr: "add #KEY"},
+		{name: "missing secret", value: "vault://secret/other#api_key", wantErr: "unable to read the Vault secret"},
+		{name: "invalid ARN", value: "arn:aws:secretsmanager::123456789012:secret:temporal", wantErr: "not a Secrets Manager secret ARN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("VAULT_NAMESPACE", tt.namespace)
+			got, err := resolveSecretReference(context.Background(), tt.value)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("resolveSecretReference(%q) error = %v, want it to mention %q", tt.value, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("resolveSecretReference(%q) = %q, %v, want %q", tt.value, got, err, tt.want)
+			}
+		})
+	}
+
+	t.Run("missing credentials", func(t *testing.T) {
+		t.Setenv("VAULT_TOKEN", "")
+		t.Setenv("HOME", t.TempDir())
+		if _, err := resolveSecretReference(context.Background(), "vault://secret/temporal#api_key"); err == nil || !strings.Contains(err.Error(), "VAULT_TOKEN") {
+			t.Errorf("resolveSecretReference() error = %v, want it to ask for VAULT_TOKEN", err)
+		}
+
+		t.Setenv("AWS_ACCESS_KEY_ID", "")
+		if _, err := resolveSecretReference(context.Background(), "arn:aws:secretsmanager:eu-west-1:123456789012:secret:temporal"); err == nil || !strings.Contains(err.Error(), "AWS_ACCESS_KEY_ID") {
+			t.Errorf("resolveSecretReference() error = %v, want it to ask for AWS credentials", err)
+		}
+	})
+}
+
+func TestTLSModelResolveSecretReferences(t *testing.T) {
+	server := fakeVault(t)
+	t.Setenv("VAULT_ADDR", server.URL)
+	t.Setenv("VAULT_TOKEN", "token")
+
+	m := tlsModel{
+		Cert: types.StringValue("vault://secret/temporal#api_key"),
+		Key:  types.StringValue("vault://secret/temporal#missing"),
+		CA:   types.StringValue("-----BEGIN CERTIFICATE-----"),
+	}
+	diags := m.resolveSecretReferences(context.Background(), path.Root("tls"))
+
+	if m.Cert.ValueString() != "v2-key" {
+		t.Errorf("cert = %q, want the resolved secret", m.Cert.ValueString())
+	}
+	if m.CA.ValueString() != "-----BEGIN CERTIFICATE-----" {
+		t.Errorf("ca = %q, want the inline value kept", m.CA.ValueString())
+	}
+	if len(diags) != 1 || diags[0].Summary() != summarySecretReference {
+		t.Fatalf("diagnostics = %v, want one secret reference error", diags)
+	}
+	if got, ok := diags[0].(interface{ Path() path.Path }); !ok || !got.Path().Equal(path.Root("tls").AtName("key")) {
+		t.Errorf("diagnostic at %v, want tls.key", got)
+	}
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	grpcCreds "google.golang.org/grpc/credentials"
+)
+
+var _ grpcCreds.PerRPCCredentials = apiKeyCredentials("")
+
+// apiKeyCredentials sends an API key as a bearer token with every RPC, the way Temporal Cloud expects it.
+type apiKeyCredentials string
+
+func (c apiKeyCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(c)}, nil
+}
+
+// RequireTransportSecurity keeps the key from being sent over a plaintext connection.
+func (c apiKeyCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+var _ grpcCreds.PerRPCCredentials = authTokenCredentials("")
+
+// authTokenCredentials sends a pre-minted token, such as a JWT for the server's default authorizer, with every RPC.
+// Tokens without a scheme are sent as bearer tokens.
+type authTokenCredentials string
+
+func (c authTokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	token := string(c)
+	if !strings.Contains(token, " ") {
+		token = "Bearer " + token
+	}
+	return map[string]string{"authorization": token}, nil
+}
+
+// RequireTransportSecurity allows plaintext connections, which self-hosted clusters often use inside
+// their own network. The provider warns when a token is sent that way.
+func (c authTokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
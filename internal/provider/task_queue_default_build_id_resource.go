@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ganievs/terraform-provider-temporal/models"
+)
+
+var (
+	_ resource.Resource                = &TaskQueueDefaultBuildIDResource{}
+	_ resource.ResourceWithConfigure   = &TaskQueueDefaultBuildIDResource{}
+	_ resource.ResourceWithImportState = &TaskQueueDefaultBuildIDResource{}
+	_ resource.ResourceWithModifyPlan  = &TaskQueueDefaultBuildIDResource{}
+)
+
+// NewTaskQueueDefaultBuildIDResource creates a new instance of TaskQueueDefaultBuildIDResource.
+func NewTaskQueueDefaultBuildIDResource() resource.Resource {
+	return &TaskQueueDefaultBuildIDResource{}
+}
+
+// TaskQueueDefaultBuildIDResource - a resource that keeps a build ID as the default of a task queue.
+type TaskQueueDefaultBuildIDResource struct {
+	client *TemporalClient
+}
+
+// Metadata sets the metadata for the task queue default build ID resource, specifically the type name.
+func (r *TaskQueueDefaultBuildIDResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_task_queue_default_build_id"
+}
+
+// Schema returns the schema for the task queue default build ID resource.
+func (r *TaskQueueDefaultBuildIDResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Default worker build ID of a task queue. A build ID the task queue does not know yet is added as a new " +
+			"default set, incompatible with the previous ones; a known build ID has its set promoted to default and is made the default " +
+			"within that set. Other compatible build IDs are left as they are. Destroying the resource leaves the task queue unchanged. " +
+			"Experimental: requires the `worker_versioning` experiment in the provider's `experiments`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the form `namespace:task_queue`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace of the task queue",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"task_queue": schema.StringAttribute{
+				MarkdownDescription: "Name of the task queue",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"build_id": schema.StringAttribute{
+				MarkdownDescription: "Build ID to make the default of the task queue",
+				Required:            true,
+			},
+			"cluster":     clusterAttribute(),
+			"rpc_timeout": rpcTimeoutAttribute(),
+		},
+	}
+}
+
+// Configure sets up the task queue default build ID resource configuration.
+func (r *TaskQueueDefaultBuildIDResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring Temporal Task Queue Default Build ID Resource")
+
+	// Prevent panic if the provider has not been configured yet
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TemporalClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			summaryResourceConfType,
+			fmt.Sprintf("Expected *provider.TemporalClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(client.requireExperiment(experimentWorkerVersioning)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client = client
+	tflog.Info(ctx, "Configured Temporal Task Queue Default Build ID client", map[string]any{"success": true})
+}
+
+// ModifyPlan checks that the server supports build ID based versioning, so that old servers fail the plan instead
+// of the apply.
+func (r *TaskQueueDefaultBuildIDResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var cluster types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cluster"), &cluster)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.client.planCapability(ctx, cluster, capabilityBuildIDVersioning, path.Root("build_id"))...)
+}
+
+// Create makes the build ID the default of the task queue.
+func (r *TaskQueueDefaultBuildIDResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.TaskQueueDefaultBuildIDResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := r.client.timeOperation(ctx, "create", "temporal_task_queue_default_build_id."+data.Namespace.ValueString()+":"+data.TaskQueue.ValueString())
+	defer done()
+	ctx = withRPCTimeout(ctx, data.RPCTimeout)
+
+	cluster, diags := r.client.cluster(data.Cluster)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.promote(ctx, cluster.workflowService, data); err != nil {
+		resp.Diagnostics.AddError(summaryRequestError, fmt.Sprintf("Unable to set the default build ID of task queue %s, got error: %s", data.TaskQueue.ValueString(), requestErrorDetail(err)))
+		return
+	}
+
+	data.ID = types.StringValue(data.Namespace.ValueString() + ":" + data.TaskQueue.ValueString())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Build ID %s is the default of task queue %s", data.BuildID.ValueString(), data.TaskQueue.ValueString()))
+}
+
+// Read refreshes the build ID from the current default of the task queue.
+func (r *TaskQueueDefaultBuildIDResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.TaskQueueDefaultBuildIDResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := r.client.timeOperation(ctx, "read", "temporal_task_queue_default_build_id."+state.Namespace.ValueString()+":"+state.TaskQueue.ValueString())
+	defer done()
+	ctx = withRPCTimeout(ctx, state.RPCTimeout)
+
+	cluster, diags := r.client.cluster(state.Cluster)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	buildID, err := r.defaultBuildID(ctx, cluster.workflowService, state.Namespace.ValueString(), state.TaskQueue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(summaryClientError, fmt.Sprintf("Unable to read the build IDs of task queue %s, got error: %s", state.TaskQueue.ValueString(), requestErrorDetail(err)))
+		return
+	}
+
+	if buildID == "" {
+		// Delete resource from state if the task queue has no versioning data anymore
+		tflog.Info(ctx, "Task queue has no default build ID, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.BuildID = types.StringValue(buildID)
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Read a Temporal task queue default build ID resource")
+}
+
+// Update makes the new build ID the default of the task queue.
+func (r *TaskQueueDefaultBuildIDResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data models.TaskQueueDefaultBuildIDResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := r.client.timeOperation(ctx, "update", "temporal_task_queue_default_build_id."+data.Namespace.ValueString()+":"+data.TaskQueue.ValueString())
+	defer done()
+	ctx = withRPCTimeout(ctx, data.RPCTimeout)
+
+	cluster, diags := r.client.cluster(data.Cluster)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.promote(ctx, cluster.workflowService, data); err != nil {
+		resp.Diagnostics.AddError(summaryRequestError, fmt.Sprintf("Unable to set the default build ID of task queue %s, got error: %s", data.TaskQueue.ValueString(), requestErrorDetail(err)))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Build ID %s is the default of task queue %s", data.BuildID.ValueString(), data.TaskQueue.ValueString()))
+}
+
+// Delete only removes the resource from the Terraform state. Workers polling the task queue need a default build ID,
+// and there is no earlier default the provider could safely restore.
+func (r *TaskQueueDefaultBuildIDResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data models.TaskQueueDefaultBuildIDResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Removed the default build ID of task queue %s from state, the task queue is left unchanged", data.TaskQueue.ValueString()))
+}
+
+// ImportState imports the default build ID of a task queue. The ID format is 'namespace:task_queue',
+// with an '@cluster' suffix for task queues of a named endpoint.
+func (r *TaskQueueDefaultBuildIDResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, cluster := splitClusterImportID(req.ID)
+	namespace, taskQueue, ok := strings.Cut(id, ":")
+	if !ok || namespace == "" || taskQueue == "" {
+		resp.Diagnostics.AddError(summaryInvalidImportID, "Expected 'namespace:task_queue'.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("task_queue"), taskQueue)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster"), cluster)...)
+}
+
+// defaultBuildID returns the default build ID of the task queue, or an empty string if it has none.
+// The default set is the last major version set, and the default within a set is its last build ID.
+func (r *TaskQueueDefaultBuildIDResource) defaultBuildID(ctx context.Context, client workflowservice.WorkflowServiceClient, namespace, taskQueue string) (string, error) {
+	compatibility, err := client.GetWorkerBuildIdCompatibility(ctx, &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+		Namespace: namespace,
+		TaskQueue: taskQueue,
+		MaxSets:   1,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", nil
+		}
+		return "", err
+	}
+
+	sets := compatibility.GetMajorVersionSets()
+	if len(sets) == 0 {
+		return "", nil
+	}
+	buildIDs := sets[len(sets)-1].GetBuildIds()
+	if len(buildIDs) == 0 {
+		return "", nil
+	}
+	return buildIDs[len(buildIDs)-1], nil
+}
+
+// promote makes the planned build ID the default of the task queue.
+func (r *TaskQueueDefaultBuildIDResource) promote(ctx context.Context, client workflowservice.WorkflowServiceClient, data models.TaskQueueDefaultBuildIDResourceModel) error {
+	namespace, taskQueue, buildID := data.Namespace.ValueString(), data.TaskQueue.ValueString(), data.BuildID.ValueString()
+
+	compatibility, err := client.GetWorkerBuildIdCompatibility(ctx, &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+		Namespace: namespace,
+		TaskQueue: taskQueue,
+	})
+	// A task queue that has never been versioned has no build IDs yet.
+	if err != nil && status.Code(err) != codes.NotFound {
+		return err
+	}
+
+	known := false
+	for _, set := range compatibility.GetMajorVersionSets() {
+		for _, id := range set.GetBuildIds() {
+			known = known || id == buildID
+		}
+	}
+
+	if !known {
+		_, err = client.UpdateWorkerBuildIdCompatibility(ctx, &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
+			Namespace: namespace,
+			TaskQueue: taskQueue,
+			Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewBuildIdInNewDefaultSet{
+				AddNewBuildIdInNewDefaultSet: buildID,
+			},
+		})
+		return err
+	}
+
+	_, err = client.UpdateWorkerBuildIdCompatibility(ctx, &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
+		Namespace: namespace,
+		TaskQueue: taskQueue,
+		Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_PromoteSetByBuildId{
+			PromoteSetByBuildId: buildID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.UpdateWorkerBuildIdCompatibility(ctx, &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
+		Namespace: namespace,
+		TaskQueue: taskQueue,
+		Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_PromoteBuildIdWithinSet{
+			PromoteBuildIdWithinSet: buildID,
+		},
+	})
+	return err
+}
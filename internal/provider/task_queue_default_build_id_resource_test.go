@@ -0,0 +1,63 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// workerVersioningProviderConfig enables the experiment the resource is gated behind.
+const workerVersioningProviderConfig = `
+provider "temporal" {
+  address        = "127.0.0.1:7233"
+  allow_insecure = true
+  experiments    = ["worker_versioning"]
+}
+`
+
+func TestAccTaskQueueDefaultBuildIDResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: workerVersioningProviderConfig + `
+				resource "temporal_task_queue_default_build_id" "test" {
+					namespace  = "default"
+					task_queue = "tf-acc-default-build-id"
+					build_id   = "1.0"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_task_queue_default_build_id.test", "id", "default:tf-acc-default-build-id"),
+					resource.TestCheckResourceAttr("temporal_task_queue_default_build_id.test", "build_id", "1.0"),
+				),
+			},
+			// Update to a new build ID, then back to the known one
+			{
+				Config: workerVersioningProviderConfig + `
+				resource "temporal_task_queue_default_build_id" "test" {
+					namespace  = "default"
+					task_queue = "tf-acc-default-build-id"
+					build_id   = "2.0"
+				}`,
+				Check: resource.TestCheckResourceAttr("temporal_task_queue_default_build_id.test", "build_id", "2.0"),
+			},
+			{
+				Config: workerVersioningProviderConfig + `
+				resource "temporal_task_queue_default_build_id" "test" {
+					namespace  = "default"
+					task_queue = "tf-acc-default-build-id"
+					build_id   = "1.0"
+				}`,
+				Check: resource.TestCheckResourceAttr("temporal_task_queue_default_build_id.test", "build_id", "1.0"),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "temporal_task_queue_default_build_id.test",
+				ImportState:       true,
+				ImportStateId:     "default:tf-acc-default-build-id",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
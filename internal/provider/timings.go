@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// operationTimingsKey is the context key of the operationTimings of a resource operation.
+type operationTimingsKey struct{}
+
+// operationTimings collects the wall time of the requests made by one resource operation.
+type operationTimings struct {
+	mu       sync.Mutex
+	calls    int
+	total    time.Duration
+	byMethod map[string]time.Duration
+}
+
+func (t *operationTimings) record(method string, elapsed time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.calls++
+	t.total += elapsed
+	t.byMethod[path.Base(method)] += elapsed
+}
+
+// timeOperation starts timing the requests of a resource operation when the timings option is enabled, and
+// records the operation for requestIDInterceptor. The returned function logs the timings summary, and must be
+// called when the operation is done.
+func (c *TemporalClient) timeOperation(ctx context.Context, operation, address string) (context.Context, func()) {
+	ctx = withResourceOperation(ctx, operation, address)
+	ctx, endSpan := c.traceOperation(ctx, operation, address)
+	if !c.timings {
+		return ctx, endSpan
+	}
+
+	timings := &operationTimings{byMethod: make(map[string]time.Duration)}
+	start := time.Now()
+
+	return context.WithValue(ctx, operationTimingsKey{}, timings), func() {
+		defer endSpan()
+		timings.mu.Lock()
+		defer timings.mu.Unlock()
+
+		methods := make(map[string]any, len(timings.byMethod))
+		for method, elapsed := range timings.byMethod {
+			methods[method] = elapsed.String()
+		}
+		tflog.Info(ctx, "Resource operation timings", map[string]any{
+			"resource":  address,
+			"operation": operation,
+			"elapsed":   time.Since(start).String(),
+			"rpc_calls": timings.calls,
+			"rpc_time":  timings.total.String(),
+			"rpc_times": methods,
+		})
+	}
+}
@@ -0,0 +1,316 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// tlsModel maps the tls block of the provider configuration.
+type tlsModel struct {
+	Cert           types.String `tfsdk:"cert"`
+	Key            types.String `tfsdk:"key"`
+	CertPath       types.String `tfsdk:"cert_path"`
+	KeyPath        types.String `tfsdk:"key_path"`
+	CA             types.String `tfsdk:"ca"`
+	CAPath         types.String `tfsdk:"ca_path"`
+	CertReloadTime types.Int64  `tfsdk:"cert_reload_time"`
+	ServerName     types.String `tfsdk:"server_name"`
+	SkipVerify     types.Bool   `tfsdk:"insecure_skip_verify"`
+	MinVersion     types.String `tfsdk:"min_version"`
+	CipherSuites   types.List   `tfsdk:"cipher_suites"`
+	ReloadBefore   types.String `tfsdk:"reload_before_expiry"`
+}
+
+// tlsVersions maps the accepted min_version values to their crypto/tls constants.
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// cipherSuites maps IANA cipher suite names to their crypto/tls identifiers.
+var cipherSuites = func() map[string]uint16 {
+	suites := make(map[string]uint16)
+	for _, suite := range append(tls.CipherSuites(), tls.InsecureCipherSuites()...) {
+		suites[suite.Name] = suite.ID
+	}
+	return suites
+}()
+
+// cipherSuiteNames returns the names accepted by the cipher_suites attribute.
+func cipherSuiteNames() []string {
+	names := make([]string, 0, len(cipherSuites))
+	for name := range cipherSuites {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// applyEnv sets attributes that are not configured from their environment variables, and reports whether
+// any was set. File paths are only taken from the environment when the inline PEM value is not configured either.
+func (m *tlsModel) applyEnv() (bool, error) {
+	set := false
+	// Variables are applied in order: the provider's own take precedence over the ones of the temporal CLI,
+	// and file paths over PEM data.
+	for _, env := range []struct {
+		name                   string
+		value, conflictingAttr *types.String
+	}{
+		{"TEMPORAL_TLS_CERT_PATH", &m.CertPath, &m.Cert},
+		{"TEMPORAL_TLS_CERT", &m.CertPath, &m.Cert},
+		{"TEMPORAL_TLS_CERT_DATA", &m.Cert, &m.CertPath},
+		{"TEMPORAL_TLS_KEY_PATH", &m.KeyPath, &m.Key},
+		{"TEMPORAL_TLS_KEY", &m.KeyPath, &m.Key},
+		{"TEMPORAL_TLS_KEY_DATA", &m.Key, &m.KeyPath},
+		{"TEMPORAL_TLS_CA_PATH", &m.CAPath, &m.CA},
+		{"TEMPORAL_TLS_CA", &m.CAPath, &m.CA},
+		{"TEMPORAL_TLS_CA_DATA", &m.CA, &m.CAPath},
+		{"TEMPORAL_TLS_SERVER_NAME", &m.ServerName, nil},
+		{"TEMPORAL_TLS_MIN_VERSION", &m.MinVersion, nil},
+		{"TEMPORAL_TLS_RELOAD_BEFORE_EXPIRY", &m.ReloadBefore, nil},
+	} {
+		value := os.Getenv(env.name)
+		if value == "" || !env.value.IsNull() || (env.conflictingAttr != nil && !env.conflictingAttr.IsNull()) {
+			continue
+		}
+		*env.value = types.StringValue(value)
+		set = true
+	}
+
+	if _, ok := os.LookupEnv("TEMPORAL_TLS_INSECURE_SKIP_VERIFY"); ok && m.SkipVerify.IsNull() {
+		skipVerify, err := getBoolEnv("TEMPORAL_TLS_INSECURE_SKIP_VERIFY")
+		if err != nil {
+			return false, fmt.Errorf("TEMPORAL_TLS_INSECURE_SKIP_VERIFY must be a boolean: %w", err)
+		}
+		m.SkipVerify = types.BoolValue(skipVerify)
+		set = true
+	}
+
+	return set, nil
+}
+
+// applySecret sets the certificates that are not configured, neither inline nor as a file, from a credentials
+// source, and reports whether any was set.
+func (m *tlsModel) applySecret(creds secretCredentials) bool {
+	set := false
+	for _, attr := range []struct {
+		value            string
+		inline, filePath *types.String
+	}{
+		{creds.TLSCert, &m.Cert, &m.CertPath},
+		{creds.TLSKey, &m.Key, &m.KeyPath},
+		{creds.TLSCA, &m.CA, &m.CAPath},
+	} {
+		if attr.value == "" || !attr.inline.IsNull() || !attr.filePath.IsNull() {
+			continue
+		}
+		*attr.inline = types.StringValue(attr.value)
+		set = true
+	}
+	return set
+}
+
+// newTLSConfig builds the client TLS configuration from the TLS settings at base, such as the tls block.
+// System roots are used when no CA is given, and a client certificate is only loaded when one is set.
+func newTLSConfig(ctx context.Context, m tlsModel, base path.Path) (*tls.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// The reloader resolves the secret references again.
+	unresolved := m
+	diags.Append(m.resolveSecretReferences(ctx, base)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	config := &tls.Config{
+		ServerName:         m.ServerName.ValueString(),
+		InsecureSkipVerify: m.SkipVerify.ValueBool(),
+	}
+
+	if config.InsecureSkipVerify {
+		diags.AddAttributeWarning(base.AtName("insecure_skip_verify"), summaryTLSVerifyDisabled,
+			"The server certificate is not verified, so anyone able to intercept the connection can impersonate the Temporal frontend "+
+				"and read the credentials sent to it. Do not use this setting outside of test environments.")
+	}
+
+	if !m.Cert.IsNull() || !m.Key.IsNull() || !m.CertPath.IsNull() || !m.KeyPath.IsNull() {
+		certPEM, err := pemValue(m.Cert, m.CertPath)
+		if err != nil {
+			diags.AddAttributeError(base.AtName("cert_path"), summaryReadClientCert, err.Error())
+			return nil, diags
+		}
+		keyPEM, err := pemValue(m.Key, m.KeyPath)
+		if err != nil {
+			diags.AddAttributeError(base.AtName("key_path"), summaryReadPrivateKey, err.Error())
+			return nil, diags
+		}
+
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			diags.AddAttributeError(base.AtName("cert"), summaryInvalidClientCert, "Unable to load the client certificate and key: "+err.Error())
+			return nil, diags
+		}
+		config.Certificates = []tls.Certificate{cert}
+
+		if !m.ReloadBefore.IsNull() {
+			before, err := time.ParseDuration(m.ReloadBefore.ValueString())
+			if err != nil {
+				diags.AddAttributeError(base.AtName("reload_before_expiry"), summaryInvalidEnvVar,
+					"The TEMPORAL_TLS_RELOAD_BEFORE_EXPIRY environment variable must be a duration, e.g. 5m: "+err.Error())
+				return nil, diags
+			}
+			reloader, err := newCertReloader(unresolved, &cert, before)
+			if err != nil {
+				diags.AddAttributeError(base.AtName("cert"), summaryInvalidClientCert, err.Error())
+				return nil, diags
+			}
+			config.Certificates = nil
+			config.GetClientCertificate = reloader.clientCertificate
+		}
+	}
+
+	if !m.CA.IsNull() || !m.CAPath.IsNull() {
+		caPEM, err := pemValue(m.CA, m.CAPath)
+		if err != nil {
+			diags.AddAttributeError(base.AtName("ca_path"), summaryReadCACerts, err.Error())
+			return nil, diags
+		}
+		config.RootCAs = getCA(caPEM)
+		if config.RootCAs.Equal(x509.NewCertPool()) {
+			diags.AddAttributeError(base.AtName("ca"), summaryInvalidCACerts, "No PEM encoded certificates were found in the CA certificates.")
+			return nil, diags
+		}
+	}
+
+	if !m.MinVersion.IsNull() {
+		version, ok := tlsVersions[m.MinVersion.ValueString()]
+		if !ok {
+			diags.AddAttributeError(base.AtName("min_version"), summaryInvalidTLSVersion,
+				fmt.Sprintf("Unsupported TLS version %q, expected one of 1.0, 1.1, 1.2 or 1.3.", m.MinVersion.ValueString()))
+			return nil, diags
+		}
+		config.MinVersion = version
+	}
+
+	if !m.CipherSuites.IsNull() {
+		var names []string
+		diags.Append(m.CipherSuites.ElementsAs(ctx, &names, false)...)
+		for _, name := range names {
+			config.CipherSuites = append(config.CipherSuites, cipherSuites[name])
+		}
+	}
+
+	return config, diags
+}
+
+// certReloader loads the client certificate again once it is about to expire, so that connections made late in a
+// long apply, e.g. while waiting for a namespace deletion, present a valid certificate. It suits certificates issued
+// with short TTLs and renewed in place, such as by a Vault agent, or behind a secret reference.
+type certReloader struct {
+	settings tlsModel
+	before   time.Duration
+
+	mu     sync.Mutex
+	cert   *tls.Certificate
+	expiry time.Time
+}
+
+// newCertReloader starts with cert, loaded from settings.
+func newCertReloader(settings tlsModel, cert *tls.Certificate, before time.Duration) (*certReloader, error) {
+	expiry, err := certificateExpiry(cert)
+	if err != nil {
+		return nil, err
+	}
+	return &certReloader{settings: settings, before: before, cert: cert, expiry: expiry}, nil
+}
+
+// clientCertificate is called for each TLS handshake. Failed reloads keep the current certificate while it is valid,
+// so a renewal that is late only fails once the certificate has expired.
+func (r *certReloader) clientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Until(r.expiry) > r.before {
+		return r.cert, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), credentialsSourceTimeout)
+	defer cancel()
+	cert, err := loadClientCertificate(ctx, r.settings)
+	if err == nil {
+		var expiry time.Time
+		if expiry, err = certificateExpiry(cert); err == nil {
+			r.cert, r.expiry = cert, expiry
+			return r.cert, nil
+		}
+	}
+	if time.Now().Before(r.expiry) {
+		return r.cert, nil
+	}
+	return nil, fmt.Errorf("the client certificate expired at %s and could not be reloaded: %w", r.expiry.Format(time.RFC3339), err)
+}
+
+// loadClientCertificate loads the client certificate and key of the TLS settings, resolving secret references.
+func loadClientCertificate(ctx context.Context, m tlsModel) (*tls.Certificate, error) {
+	if diags := m.resolveSecretReferences(ctx, path.Empty()); diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Detail())
+	}
+	certPEM, err := pemValue(m.Cert, m.CertPath)
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := pemValue(m.Key, m.KeyPath)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	return &cert, nil
+}
+
+// certificateExpiry returns when the leaf of a certificate chain expires.
+func certificateExpiry(cert *tls.Certificate) (time.Time, error) {
+	if len(cert.Certificate) == 0 {
+		return time.Time{}, fmt.Errorf("the client certificate chain is empty")
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to parse the client certificate: %w", err)
+	}
+	return leaf.NotAfter, nil
+}
+
+// pemValue returns the inline PEM value, or the contents of the file at filePath if the inline value is not set.
+func pemValue(inline, filePath types.String) ([]byte, error) {
+	if filePath.IsNull() {
+		return []byte(normalizeCert(inline.ValueString())), nil
+	}
+	return os.ReadFile(filePath.ValueString())
+}
+
+// Function to get CA certificates.
+func getCA(caCerts []byte) *x509.CertPool {
+	caCertPool := x509.NewCertPool()
+	caCertPool.AppendCertsFromPEM(caCerts)
+	return caCertPool
+}
+
+// Helper function to remove line return escaping from cert.
+func normalizeCert(value string) string {
+	return strings.ReplaceAll(value, "\\n", "\n")
+}
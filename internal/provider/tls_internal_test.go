@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// writeTestCertificate writes a self-signed client certificate that expires at notAfter, and its key, to dir.
+func writeTestCertificate(t *testing.T, dir string, notAfter time.Time) tlsModel {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(notAfter.UnixNano()),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPath, keyPath := filepath.Join(dir, "client.pem"), filepath.Join(dir, "client.key")
+	if err := os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return tlsModel{CertPath: types.StringValue(certPath), KeyPath: types.StringValue(keyPath)}
+}
+
+// expiryOf returns when the certificate returned by a reloader expires.
+func expiryOf(t *testing.T, cert *tls.Certificate) time.Time {
+	t.Helper()
+	expiry, err := certificateExpiry(cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return expiry
+}
+
+func TestCertReloader(t *testing.T) {
+	const before = time.Hour
+	now := time.Now().Truncate(time.Second)
+
+	tests := []struct {
+		name       string
+		current    time.Time
+		renewed    time.Time // zero when the renewal is missing
+		wantExpiry time.Time
+		wantErr    bool
+	}{
+		{name: "valid certificate is kept", current: now.Add(24 * time.Hour), renewed: now.Add(48 * time.Hour), wantExpiry: now.Add(24 * time.Hour)},
+		{name: "expiring certificate is reloaded", current: now.Add(time.Minute), renewed: now.Add(48 * time.Hour), wantExpiry: now.Add(48 * time.Hour)},
+		{name: "expiring certificate is kept while the renewal is late", current: now.Add(time.Minute), wantExpiry: now.Add(time.Minute)},
+		{name: "expired certificate without renewal fails", current: now.Add(-time.Minute), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			settings := writeTestCertificate(t, dir, tt.current)
+			cert, err := loadClientCertificate(context.Background(), settings)
+			if err != nil {
+				t.Fatal(err)
+			}
+			reloader, err := newCertReloader(settings, cert, before)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.renewed.IsZero() {
+				if err := os.Remove(settings.CertPath.ValueString()); err != nil {
+					t.Fatal(err)
+				}
+			} else {
+				writeTestCertificate(t, dir, tt.renewed)
+			}
+
+			got, err := reloader.clientCertificate(&tls.CertificateRequestInfo{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("clientCertificate() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if expiry := expiryOf(t, got); !expiry.Equal(tt.wantExpiry) {
+				t.Errorf("clientCertificate() expires at %s, want %s", expiry, tt.wantExpiry)
+			}
+		})
+	}
+}
+
+func TestNewTLSConfigReload(t *testing.T) {
+	settings := writeTestCertificate(t, t.TempDir(), time.Now().Add(24*time.Hour))
+
+	config, diags := newTLSConfig(context.Background(), settings, path.Root("tls"))
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(config.Certificates) != 1 || config.GetClientCertificate != nil {
+		t.Errorf("without reload_before_expiry, want a static certificate")
+	}
+
+	settings.ReloadBefore = types.StringValue("5m")
+	config, diags = newTLSConfig(context.Background(), settings, path.Root("tls"))
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(config.Certificates) != 0 || config.GetClientCertificate == nil {
+		t.Errorf("with reload_before_expiry, want the certificate to come from the reloader")
+	}
+
+	settings.ReloadBefore = types.StringValue("soon")
+	if _, diags = newTLSConfig(context.Background(), settings, path.Root("tls")); !diags.HasError() {
+		t.Error("newTLSConfig() accepted an invalid reload_before_expiry")
+	}
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// tracerName is the instrumentation scope of the spans of resource operations.
+const tracerName = "terraform-provider-temporal"
+
+// tracing holds the tracer provider of the plugin process. It is created by the first provider configured with
+// tracing enabled, and shared by all of them, so that spans are exported over one connection.
+var tracing struct {
+	once     sync.Once
+	provider *sdktrace.TracerProvider
+	parent   trace.SpanContext
+	err      error
+}
+
+// tracerProvider returns the tracer provider of the process. Spans are exported with OTLP over gRPC, and the
+// exporter, sampler and resource are configured with the standard OTEL_* environment variables. The service name
+// defaults to the name of the provider.
+func tracerProvider(ctx context.Context, version string) (*sdktrace.TracerProvider, error) {
+	tracing.once.Do(func() {
+		exporter, err := otlptracegrpc.New(ctx)
+		if err != nil {
+			tracing.err = err
+			return
+		}
+		// Attributes from OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES take precedence over the defaults.
+		res, err := sdkresource.New(ctx,
+			sdkresource.WithAttributes(semconv.ServiceName(tracerName), semconv.ServiceVersion(version)),
+			sdkresource.WithTelemetrySDK(),
+			sdkresource.WithFromEnv(),
+		)
+		if err != nil {
+			tracing.err = err
+			return
+		}
+		tracing.provider = sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+
+		// A TRACEPARENT environment variable, as set by CI systems that trace their pipelines, makes the
+		// resource operations children of that trace.
+		carrier := propagation.MapCarrier{"traceparent": os.Getenv("TRACEPARENT"), "tracestate": os.Getenv("TRACESTATE")}
+		tracing.parent = trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), carrier))
+	})
+	return tracing.provider, tracing.err
+}
+
+// ShutdownTracing exports the spans that are still buffered. It is called when the plugin process exits, and does
+// nothing when tracing was not enabled.
+func ShutdownTracing(ctx context.Context) error {
+	if tracing.provider == nil {
+		return nil
+	}
+	return tracing.provider.Shutdown(ctx)
+}
+
+// tracingDialOption installs the otelgrpc stats handler, which records a span for each RPC and propagates the trace
+// context to the frontend in the traceparent header.
+func tracingDialOption(provider trace.TracerProvider) grpc.DialOption {
+	return grpc.WithStatsHandler(otelgrpc.NewClientHandler(
+		otelgrpc.WithTracerProvider(provider),
+		otelgrpc.WithPropagators(propagation.TraceContext{}),
+	))
+}
+
+// tracingInterceptor records a span for each RPC made over the HTTP transport, which the otelgrpc stats handler
+// does not see.
+func tracingInterceptor(provider trace.TracerProvider) grpc.UnaryClientInterceptor {
+	tracer := provider.Tracer(tracerName)
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, span := tracer.Start(ctx, strings.TrimPrefix(method, "/"),
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				semconv.RPCService(strings.TrimPrefix(path.Dir(method), "/")),
+				semconv.RPCMethod(path.Base(method)),
+			),
+		)
+		defer span.End()
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		span.SetAttributes(semconv.RPCGRPCStatusCodeKey.Int(int(status.Code(err))))
+		if err != nil {
+			span.SetStatus(otelcodes.Error, status.Convert(err).Message())
+		}
+		return err
+	}
+}
+
+// traceOperation starts the span of a resource operation when tracing is enabled, so that the spans of its RPCs
+// are grouped under it. The returned function ends the span.
+func (c *TemporalClient) traceOperation(ctx context.Context, operation, address string) (context.Context, func()) {
+	if c.tracer == nil {
+		return ctx, func() {}
+	}
+
+	if !trace.SpanContextFromContext(ctx).IsValid() && tracing.parent.IsValid() {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, tracing.parent)
+	}
+	ctx, span := c.tracer.Start(ctx, address+" "+operation, trace.WithAttributes(
+		attribute.String("terraform.resource", address),
+		attribute.String("terraform.operation", operation),
+	))
+	return ctx, func() { span.End() }
+}
@@ -0,0 +1,293 @@
+package provider_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/ganievs/terraform-provider-temporal/internal/provider"
+	"github.com/ganievs/terraform-provider-temporal/internal/testserver"
+)
+
+// goldenState is a resource state recorded with an earlier provider version, with the configuration that produced
+// it and the server data it was read from. See testdata/upgrade/README.md.
+type goldenState struct {
+	ProviderVersion string          `json:"provider_version"`
+	ResourceType    string          `json:"resource_type"`
+	SchemaVersion   int64           `json:"schema_version"`
+	Config          map[string]any  `json:"config"`
+	State           json.RawMessage `json:"state"`
+	Server          struct {
+		Namespaces       []json.RawMessage            `json:"namespaces"`
+		SearchAttributes map[string]map[string]string `json:"search_attributes"`
+	} `json:"server"`
+}
+
+// TestUpgradeGoldenStates plans every recorded state against the current provider, with the configuration it was
+// recorded with, and fails if the plan has any change. A failure means that users upgrading from that provider
+// version would see an unexpected diff, or a replacement, without changing their configuration.
+func TestUpgradeGoldenStates(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "upgrade", "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no golden states found in testdata/upgrade")
+	}
+
+	for _, file := range files {
+		t.Run(strings.TrimSuffix(filepath.Base(file), ".json"), func(t *testing.T) {
+			testGoldenState(t, file)
+		})
+	}
+}
+
+func testGoldenState(t *testing.T, file string) {
+	ctx := context.Background()
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	var golden goldenState
+	if err := decoder.Decode(&golden); err != nil {
+		t.Fatalf("decoding %s: %s", file, err)
+	}
+
+	server := testserver.New()
+	if err := server.Start("127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+	defer server.Stop()
+	for _, raw := range golden.Server.Namespaces {
+		ns := &workflowservice.DescribeNamespaceResponse{}
+		if err := protojson.Unmarshal(raw, ns); err != nil {
+			t.Fatalf("decoding server namespace: %s", err)
+		}
+		server.AddNamespace(ns)
+	}
+	for namespace, attributes := range golden.Server.SearchAttributes {
+		for name, valueType := range attributes {
+			indexedValueType, err := enums.IndexedValueTypeFromString(valueType)
+			if err != nil {
+				t.Fatalf("search attribute %s: %s", name, err)
+			}
+			server.AddSearchAttribute(namespace, name, indexedValueType)
+		}
+	}
+
+	providerServer, err := providerserver.NewProtocol6WithError(provider.New("test")())()
+	if err != nil {
+		t.Fatal(err)
+	}
+	schemas, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkDiagnostics(t, "GetProviderSchema", schemas.Diagnostics)
+
+	providerConfig := blockValue(t, schemas.Provider.Block, map[string]any{"address": server.Address(), "allow_insecure": true})
+	configured, err := providerServer.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		TerraformVersion: "1.9.0",
+		Config:           dynamicValue(t, providerConfig),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkDiagnostics(t, "ConfigureProvider", configured.Diagnostics)
+
+	schema, ok := schemas.ResourceSchemas[golden.ResourceType]
+	if !ok {
+		t.Fatalf("resource type %s no longer exists", golden.ResourceType)
+	}
+	valueType := schema.ValueType()
+
+	upgraded, err := providerServer.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: golden.ResourceType,
+		Version:  golden.SchemaVersion,
+		RawState: &tfprotov6.RawState{JSON: golden.State},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkDiagnostics(t, "UpgradeResourceState", upgraded.Diagnostics)
+
+	read, err := providerServer.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		TypeName:     golden.ResourceType,
+		CurrentState: upgraded.UpgradedState,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkDiagnostics(t, "ReadResource", read.Diagnostics)
+	prior, err := read.NewState.Unmarshal(valueType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prior.IsNull() {
+		t.Fatal("the resource was removed from the state when it was read")
+	}
+
+	config := blockValue(t, schema.Block, golden.Config)
+	planned, err := providerServer.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         golden.ResourceType,
+		PriorState:       read.NewState,
+		ProposedNewState: dynamicValue(t, proposedNewState(schema.Block, prior, config)),
+		Config:           dynamicValue(t, config),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkDiagnostics(t, "PlanResourceChange", planned.Diagnostics)
+	if len(planned.RequiresReplace) > 0 {
+		t.Errorf("the plan replaces the resource because of %v", planned.RequiresReplace)
+	}
+
+	plannedState, err := planned.PlannedState.Unmarshal(valueType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diffs, err := prior.Diff(plannedState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, diff := range diffs {
+		t.Errorf("unexpected diff of a state recorded with provider %s at %s: %s => %s",
+			golden.ProviderVersion, diff.Path, formatValue(diff.Value1), formatValue(diff.Value2))
+	}
+}
+
+// proposedNewState merges the configuration into the prior state the way Terraform does before planning: computed
+// attributes that are not configured keep their prior value. Nested attributes are merged as a whole.
+func proposedNewState(block *tfprotov6.SchemaBlock, prior, config tftypes.Value) tftypes.Value {
+	var priorAttributes, attributes map[string]tftypes.Value
+	_ = prior.As(&priorAttributes)
+	_ = config.As(&attributes)
+
+	for _, attribute := range block.Attributes {
+		if attribute.Computed && attributes[attribute.Name].IsNull() {
+			attributes[attribute.Name] = priorAttributes[attribute.Name]
+		}
+	}
+	return tftypes.NewValue(config.Type(), attributes)
+}
+
+// blockValue returns the value of a schema block with the given attribute values. Attributes that are not given
+// are null.
+func blockValue(t *testing.T, block *tfprotov6.SchemaBlock, values map[string]any) tftypes.Value {
+	t.Helper()
+
+	value, err := jsonValue(block.ValueType(), map[string]any(values))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return value
+}
+
+// jsonValue converts a decoded JSON value to a value of typ.
+func jsonValue(typ tftypes.Type, value any) (tftypes.Value, error) {
+	if value == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.String), typ.Is(tftypes.Bool):
+		return tftypes.NewValue(typ, value), nil
+	case typ.Is(tftypes.Number):
+		number, ok := value.(json.Number)
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected a number, got %v", value)
+		}
+		f, _, err := big.ParseFloat(number.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		return tftypes.NewValue(typ, f), nil
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}):
+		var elementType tftypes.Type
+		if list, ok := typ.(tftypes.List); ok {
+			elementType = list.ElementType
+		} else {
+			elementType = typ.(tftypes.Set).ElementType
+		}
+		elements := make([]tftypes.Value, 0, len(value.([]any)))
+		for _, element := range value.([]any) {
+			converted, err := jsonValue(elementType, element)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			elements = append(elements, converted)
+		}
+		return tftypes.NewValue(typ, elements), nil
+	case typ.Is(tftypes.Map{}):
+		elements := make(map[string]tftypes.Value)
+		for key, element := range value.(map[string]any) {
+			converted, err := jsonValue(typ.(tftypes.Map).ElementType, element)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			elements[key] = converted
+		}
+		return tftypes.NewValue(typ, elements), nil
+	case typ.Is(tftypes.Object{}):
+		values := value.(map[string]any)
+		attributes := make(map[string]tftypes.Value)
+		for name, attributeType := range typ.(tftypes.Object).AttributeTypes {
+			converted, err := jsonValue(attributeType, values[name])
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %w", name, err)
+			}
+			attributes[name] = converted
+		}
+		for name := range values {
+			if _, ok := attributes[name]; !ok {
+				return tftypes.Value{}, fmt.Errorf("unknown attribute %s", name)
+			}
+		}
+		return tftypes.NewValue(typ, attributes), nil
+	default:
+		return tftypes.Value{}, fmt.Errorf("unsupported type %s", typ)
+	}
+}
+
+func dynamicValue(t *testing.T, value tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	dv, err := tfprotov6.NewDynamicValue(value.Type(), value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &dv
+}
+
+func checkDiagnostics(t *testing.T, call string, diagnostics []*tfprotov6.Diagnostic) {
+	t.Helper()
+
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("%s: %s: %s", call, diagnostic.Summary, diagnostic.Detail)
+		}
+	}
+}
+
+func formatValue(value *tftypes.Value) string {
+	if value == nil {
+		return "<absent>"
+	}
+	return value.String()
+}
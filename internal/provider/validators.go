@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ validator.String = durationValidator{}
+	_ validator.String = hostPortValidator{}
+)
+
+// durationValidator checks that a string attribute is a valid Go duration such as "30s" or "1m30s".
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a duration such as \"30s\" or \"1m30s\""
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, summaryInvalidDuration, fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), err))
+		return
+	}
+	if d < 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, summaryInvalidDuration, fmt.Sprintf("Attribute %s must not be negative, got: %s", req.Path, req.ConfigValue.ValueString()))
+	}
+}
+
+// isDuration returns a validator which ensures that a string attribute is a valid duration.
+func isDuration() validator.String {
+	return durationValidator{}
+}
+
+// hostPortValidator checks that a string attribute is an address in host:port form.
+type hostPortValidator struct{}
+
+func (v hostPortValidator) Description(ctx context.Context) string {
+	return "value must be an address such as \"temporal.example.com:7233\""
+}
+
+func (v hostPortValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v hostPortValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	host, port, err := net.SplitHostPort(req.ConfigValue.ValueString())
+	if err == nil && host == "" {
+		err = fmt.Errorf("missing host")
+	}
+	if err == nil {
+		if n, convErr := strconv.ParseUint(port, 10, 16); convErr != nil || n == 0 {
+			err = fmt.Errorf("invalid port %q", port)
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, summaryInvalidAddress, fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), err))
+	}
+}
+
+// isHostPort returns a validator which ensures that a string attribute is a host:port address.
+func isHostPort() validator.String {
+	return hostPortValidator{}
+}
+
+// proxyURLValidator checks that a string attribute is a proxy URL with a supported scheme.
+type proxyURLValidator struct{}
+
+func (v proxyURLValidator) Description(ctx context.Context) string {
+	return "value must be a proxy URL such as \"http://proxy.example.com:3128\" or \"socks5://proxy.example.com:1080\""
+}
+
+func (v proxyURLValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v proxyURLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseProxyURL(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, summaryInvalidProxyURL, fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), err))
+	}
+}
+
+// isProxyURL returns a validator which ensures that a string attribute is a supported proxy URL.
+func isProxyURL() validator.String {
+	return proxyURLValidator{}
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ganievs/terraform-provider-temporal/internal/convert"
+	"github.com/ganievs/terraform-provider-temporal/models"
+)
+
+const (
+	defaultHistoryCountBucketSize  = "24h"
+	defaultHistoryCountBucketCount = 7
+)
+
+// Ensures that WorkflowHistoryCountDataSource fully satisfies the datasource.DataSource and
+// datasource.DataSourceWithConfigure interfaces.
+var (
+	_ datasource.DataSource              = &WorkflowHistoryCountDataSource{}
+	_ datasource.DataSourceWithConfigure = &WorkflowHistoryCountDataSource{}
+)
+
+// NewWorkflowHistoryCountDataSource returns a new instance of the WorkflowHistoryCountDataSource.
+func NewWorkflowHistoryCountDataSource() datasource.DataSource {
+	return &WorkflowHistoryCountDataSource{}
+}
+
+// WorkflowHistoryCountDataSource implements the Terraform data source interface for counting closed workflow executions.
+type WorkflowHistoryCountDataSource struct {
+	client workflowservice.WorkflowServiceClient
+}
+
+// Metadata sets the metadata for the Temporal workflow history count data source, specifically the type name.
+func (d *WorkflowHistoryCountDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_history_count"
+}
+
+// Schema defines the schema for the Temporal workflow history count data source.
+func (d *WorkflowHistoryCountDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Number of closed workflow executions in a namespace, bucketed by close time. " +
+			"Useful to size retention and archival. Requires a visibility store that supports `CloseTime` queries.",
+
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace to count workflow executions in",
+				Required:            true,
+			},
+			"bucket_size": schema.StringAttribute{
+				MarkdownDescription: "Length of each bucket as a duration, e.g. `24h`. Defaults to `" + defaultHistoryCountBucketSize + "`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					isDuration(),
+				},
+			},
+			"bucket_count": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of buckets, counting back from now. Defaults to `%d`.", defaultHistoryCountBucketCount),
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 366),
+				},
+			},
+			"total": schema.Int64Attribute{
+				MarkdownDescription: "Number of workflow executions closed within all buckets",
+				Computed:            true,
+			},
+			"buckets": schema.ListNestedAttribute{
+				MarkdownDescription: "Closed workflow execution counts, oldest bucket first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start_time": schema.StringAttribute{
+							MarkdownDescription: "Start of the bucket (inclusive), in RFC 3339 format",
+							Computed:            true,
+						},
+						"end_time": schema.StringAttribute{
+							MarkdownDescription: "End of the bucket (exclusive), in RFC 3339 format",
+							Computed:            true,
+						},
+						"count": schema.Int64Attribute{
+							MarkdownDescription: "Number of workflow executions closed within the bucket",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure sets up the workflow history count data source configuration.
+func (d *WorkflowHistoryCountDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring Temporal Workflow History Count DataSource")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	connection, ok := req.ProviderData.(*TemporalClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			summaryDataSourceConfType,
+			fmt.Sprintf("Expected *provider.TemporalClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = connection.workflowService
+
+	tflog.Info(ctx, "Configured Temporal Workflow History Count client", map[string]any{"success": true})
+}
+
+// Read counts the closed workflow executions of each bucket and sets them in the Terraform state.
+func (d *WorkflowHistoryCountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Temporal Workflow History Count")
+
+	var data models.WorkflowHistoryCountDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.BucketSize.IsNull() {
+		data.BucketSize = types.StringValue(defaultHistoryCountBucketSize)
+	}
+	if data.BucketCount.IsNull() {
+		data.BucketCount = types.Int64Value(defaultHistoryCountBucketCount)
+	}
+
+	// The value has already been checked by the schema validator.
+	bucketSize, _ := time.ParseDuration(data.BucketSize.ValueString())
+	if bucketSize <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("bucket_size"), summaryInvalidBucketSize, "Attribute bucket_size must be longer than zero.")
+		return
+	}
+
+	// Align the buckets so that repeated reads within the same bucket return the same ranges.
+	end := time.Now().UTC().Truncate(bucketSize).Add(bucketSize)
+	start := end.Add(-time.Duration(data.BucketCount.ValueInt64()) * bucketSize)
+
+	data.Total = types.Int64Value(0)
+	data.Buckets = []models.HistoryCountBucketModel{}
+	for bucketStart := start; bucketStart.Before(end); bucketStart = bucketStart.Add(bucketSize) {
+		bucketEnd := bucketStart.Add(bucketSize)
+
+		count, err := d.client.CountWorkflowExecutions(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+			Namespace: data.Namespace.ValueString(),
+			Query: fmt.Sprintf("ExecutionStatus != 'Running' AND CloseTime >= '%s' AND CloseTime < '%s'",
+				bucketStart.Format(time.RFC3339), bucketEnd.Format(time.RFC3339)),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(summaryClientError, fmt.Sprintf("Unable to count workflow executions, got error: %s", requestErrorDetail(err)))
+			return
+		}
+
+		data.Buckets = append(data.Buckets, models.HistoryCountBucketModel{
+			StartTime: convert.Timestamp(timestamppb.New(bucketStart)),
+			EndTime:   convert.Timestamp(timestamppb.New(bucketEnd)),
+			Count:     types.Int64Value(count.GetCount()),
+		})
+		data.Total = types.Int64Value(data.Total.ValueInt64() + count.GetCount())
+	}
+
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,30 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWorkflowHistoryCountDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "temporal_workflow_history_count" "default" {
+	namespace    = "default"
+	bucket_count = 3
+}
+`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.temporal_workflow_history_count.default", "bucket_size", "24h"),
+					resource.TestCheckResourceAttr("data.temporal_workflow_history_count.default", "buckets.#", "3"),
+					resource.TestCheckResourceAttrSet("data.temporal_workflow_history_count.default", "total"),
+				),
+			},
+		},
+	})
+}
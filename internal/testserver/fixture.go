@@ -0,0 +1,54 @@
+package testserver
+
+import (
+	"time"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/replication/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// FixtureAddress is the address the fixture server listens on by default, the one of a local Temporal server.
+const FixtureAddress = "127.0.0.1:7233"
+
+// FixtureNamespace is the namespace the fixture server starts with, like a Temporal dev server.
+const FixtureNamespace = "default"
+
+// FixtureRetentionDays is the retention of FixtureNamespace.
+const FixtureRetentionDays = 3
+
+// FixtureSearchAttributes are the custom search attributes FixtureNamespace starts with.
+var FixtureSearchAttributes = map[string]enums.IndexedValueType{
+	"CustomKeywordField": enums.INDEXED_VALUE_TYPE_KEYWORD,
+	"CustomIntField":     enums.INDEXED_VALUE_TYPE_INT,
+	"CustomTextField":    enums.INDEXED_VALUE_TYPE_TEXT,
+}
+
+// NewFixture returns a server seeded like a fresh Temporal dev server, for testing Terraform modules that use the
+// provider without a Temporal cluster.
+func NewFixture() *Server {
+	s := New()
+	s.AddNamespace(&workflowservice.DescribeNamespaceResponse{
+		NamespaceInfo: &namespace.NamespaceInfo{
+			Name:  FixtureNamespace,
+			State: enums.NAMESPACE_STATE_REGISTERED,
+			Id:    "00000000-0000-0000-0000-000000000000",
+		},
+		Config: &namespace.NamespaceConfig{
+			WorkflowExecutionRetentionTtl: durationpb.New(FixtureRetentionDays * 24 * time.Hour),
+			HistoryArchivalState:          enums.ARCHIVAL_STATE_DISABLED,
+			VisibilityArchivalState:       enums.ARCHIVAL_STATE_DISABLED,
+		},
+		ReplicationConfig: &replication.NamespaceReplicationConfig{
+			ActiveClusterName: ClusterName,
+			Clusters:          []*replication.ClusterReplicationConfig{{ClusterName: ClusterName}},
+			State:             enums.REPLICATION_STATE_NORMAL,
+		},
+	})
+	for name, valueType := range FixtureSearchAttributes {
+		s.AddSearchAttribute(FixtureNamespace, name, valueType)
+	}
+	return s
+}
@@ -0,0 +1,389 @@
+// Package testserver is an in-memory Temporal frontend for tests that run the provider without a Temporal cluster.
+//
+// It serves the parts of the WorkflowService and OperatorService the provider manages: namespaces, custom search
+// attributes and the default build IDs of task queues. Its data is seeded by the test or changed by the provider,
+// and every other method, such as the schedule methods the namespace health check needs, returns Unimplemented.
+package testserver
+
+import (
+	"context"
+	"net"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/replication/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// ServerVersion is the server version the server reports.
+const ServerVersion = "1.25.0"
+
+// ClusterName is the name of the cluster namespaces are registered in when the request does not name one.
+const ClusterName = "active"
+
+// Server is an in-memory Temporal frontend. It is safe for concurrent use.
+type Server struct {
+	workflowservice.UnimplementedWorkflowServiceServer
+	operatorservice.UnimplementedOperatorServiceServer
+
+	mu               sync.Mutex
+	namespaces       map[string]*workflowservice.DescribeNamespaceResponse
+	searchAttributes map[string]map[string]enums.IndexedValueType
+	// buildIDs holds the build IDs of each task queue, each in a set of its own. The last one is the default.
+	buildIDs map[taskQueueKey][]string
+
+	grpcServer *grpc.Server
+	listener   net.Listener
+}
+
+// taskQueueKey identifies a task queue of a namespace.
+type taskQueueKey struct {
+	namespace string
+	taskQueue string
+}
+
+// New returns an empty server. It is not listening until Start is called.
+func New() *Server {
+	return &Server{
+		namespaces:       make(map[string]*workflowservice.DescribeNamespaceResponse),
+		searchAttributes: make(map[string]map[string]enums.IndexedValueType),
+		buildIDs:         make(map[taskQueueKey][]string),
+	}
+}
+
+// Start listens on address, e.g. "127.0.0.1:0" for a free port, and serves requests until Stop is called.
+func (s *Server) Start(address string) error {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	s.listener = listener
+	s.grpcServer = grpc.NewServer(grpc.ChainUnaryInterceptor(statusInterceptor))
+	workflowservice.RegisterWorkflowServiceServer(s.grpcServer, s)
+	operatorservice.RegisterOperatorServiceServer(s.grpcServer, s)
+	go func() { _ = s.grpcServer.Serve(listener) }()
+	return nil
+}
+
+// statusInterceptor sends service errors with their gRPC code and details, as the Temporal server does.
+func statusInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, serviceerror.ToStatus(err).Err()
+	}
+	return resp, nil
+}
+
+// Address returns the address the server listens on.
+func (s *Server) Address() string {
+	return s.listener.Addr().String()
+}
+
+// Stop closes the listener and the open connections.
+func (s *Server) Stop() {
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+	}
+}
+
+// AddNamespace adds a namespace, as returned by DescribeNamespace.
+func (s *Server) AddNamespace(ns *workflowservice.DescribeNamespaceResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.namespaces[ns.GetNamespaceInfo().GetName()] = proto.Clone(ns).(*workflowservice.DescribeNamespaceResponse)
+}
+
+// AddSearchAttribute adds a custom search attribute to a namespace.
+func (s *Server) AddSearchAttribute(namespace, name string, valueType enums.IndexedValueType) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.searchAttributes[namespace] == nil {
+		s.searchAttributes[namespace] = make(map[string]enums.IndexedValueType)
+	}
+	s.searchAttributes[namespace][name] = valueType
+}
+
+// SetDefaultBuildID sets the default build ID of a task queue.
+func (s *Server) SetDefaultBuildID(namespace, taskQueue, buildID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.promoteBuildID(taskQueueKey{namespace: namespace, taskQueue: taskQueue}, buildID)
+}
+
+// promoteBuildID makes buildID the default of a task queue, adding it when it is new.
+func (s *Server) promoteBuildID(key taskQueueKey, buildID string) {
+	s.buildIDs[key] = append(slices.DeleteFunc(s.buildIDs[key], func(id string) bool { return id == buildID }), buildID)
+}
+
+// GetSystemInfo reports the capabilities of a current server.
+func (s *Server) GetSystemInfo(context.Context, *workflowservice.GetSystemInfoRequest) (*workflowservice.GetSystemInfoResponse, error) {
+	return &workflowservice.GetSystemInfoResponse{
+		ServerVersion: ServerVersion,
+		Capabilities: &workflowservice.GetSystemInfoResponse_Capabilities{
+			SupportsSchedules:      true,
+			BuildIdBasedVersioning: true,
+		},
+	}, nil
+}
+
+// DescribeNamespace returns a namespace added with AddNamespace.
+func (s *Server) DescribeNamespace(_ context.Context, req *workflowservice.DescribeNamespaceRequest) (*workflowservice.DescribeNamespaceResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ns, ok := s.namespaces[req.GetNamespace()]
+	if !ok {
+		return nil, serviceerror.NewNamespaceNotFound(req.GetNamespace())
+	}
+	return proto.Clone(ns).(*workflowservice.DescribeNamespaceResponse), nil
+}
+
+// RegisterNamespace adds a namespace in the Registered state.
+func (s *Server) RegisterNamespace(_ context.Context, req *workflowservice.RegisterNamespaceRequest) (*workflowservice.RegisterNamespaceResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.namespaces[req.GetNamespace()]; ok {
+		return nil, serviceerror.NewNamespaceAlreadyExists("Namespace already exists.")
+	}
+
+	activeCluster := req.GetActiveClusterName()
+	if activeCluster == "" {
+		activeCluster = ClusterName
+	}
+	clusters := req.GetClusters()
+	if len(clusters) == 0 {
+		clusters = []*replication.ClusterReplicationConfig{{ClusterName: activeCluster}}
+	}
+	s.namespaces[req.GetNamespace()] = &workflowservice.DescribeNamespaceResponse{
+		NamespaceInfo: &namespace.NamespaceInfo{
+			Name:        req.GetNamespace(),
+			State:       enums.NAMESPACE_STATE_REGISTERED,
+			Description: req.GetDescription(),
+			OwnerEmail:  req.GetOwnerEmail(),
+			Data:        req.GetData(),
+			Id:          uuid.NewString(),
+		},
+		Config: &namespace.NamespaceConfig{
+			WorkflowExecutionRetentionTtl: req.GetWorkflowExecutionRetentionPeriod(),
+			HistoryArchivalState:          archivalState(req.GetHistoryArchivalState()),
+			HistoryArchivalUri:            req.GetHistoryArchivalUri(),
+			VisibilityArchivalState:       archivalState(req.GetVisibilityArchivalState()),
+			VisibilityArchivalUri:         req.GetVisibilityArchivalUri(),
+		},
+		ReplicationConfig: &replication.NamespaceReplicationConfig{
+			ActiveClusterName: activeCluster,
+			Clusters:          clusters,
+			State:             enums.REPLICATION_STATE_NORMAL,
+		},
+		IsGlobalNamespace: req.GetIsGlobalNamespace(),
+	}
+	return &workflowservice.RegisterNamespaceResponse{}, nil
+}
+
+// archivalState returns the state archival has when it is not set, Disabled.
+func archivalState(state enums.ArchivalState) enums.ArchivalState {
+	if state == enums.ARCHIVAL_STATE_UNSPECIFIED {
+		return enums.ARCHIVAL_STATE_DISABLED
+	}
+	return state
+}
+
+// UpdateNamespace changes the fields of a namespace that are set in the request. Data keys are merged into the
+// existing ones, as the server does.
+func (s *Server) UpdateNamespace(_ context.Context, req *workflowservice.UpdateNamespaceRequest) (*workflowservice.UpdateNamespaceResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ns, ok := s.namespaces[req.GetNamespace()]
+	if !ok {
+		return nil, serviceerror.NewNamespaceNotFound(req.GetNamespace())
+	}
+
+	info, update := ns.GetNamespaceInfo(), req.GetUpdateInfo()
+	if update.GetDescription() != "" {
+		info.Description = update.GetDescription()
+	}
+	if update.GetOwnerEmail() != "" {
+		info.OwnerEmail = update.GetOwnerEmail()
+	}
+	if update.GetState() != enums.NAMESPACE_STATE_UNSPECIFIED {
+		info.State = update.GetState()
+	}
+	if len(update.GetData()) > 0 && info.Data == nil {
+		info.Data = make(map[string]string, len(update.GetData()))
+	}
+	for key, value := range update.GetData() {
+		info.Data[key] = value
+	}
+
+	config, configUpdate := ns.GetConfig(), req.GetConfig()
+	if configUpdate.GetWorkflowExecutionRetentionTtl() != nil {
+		config.WorkflowExecutionRetentionTtl = configUpdate.GetWorkflowExecutionRetentionTtl()
+	}
+	if configUpdate.GetHistoryArchivalState() != enums.ARCHIVAL_STATE_UNSPECIFIED {
+		config.HistoryArchivalState = configUpdate.GetHistoryArchivalState()
+	}
+	if configUpdate.GetHistoryArchivalUri() != "" {
+		config.HistoryArchivalUri = configUpdate.GetHistoryArchivalUri()
+	}
+	if configUpdate.GetVisibilityArchivalState() != enums.ARCHIVAL_STATE_UNSPECIFIED {
+		config.VisibilityArchivalState = configUpdate.GetVisibilityArchivalState()
+	}
+	if configUpdate.GetVisibilityArchivalUri() != "" {
+		config.VisibilityArchivalUri = configUpdate.GetVisibilityArchivalUri()
+	}
+
+	if activeCluster := req.GetReplicationConfig().GetActiveClusterName(); activeCluster != "" {
+		ns.ReplicationConfig.ActiveClusterName = activeCluster
+	}
+	if req.GetPromoteNamespace() {
+		ns.IsGlobalNamespace = true
+	}
+
+	return &workflowservice.UpdateNamespaceResponse{
+		NamespaceInfo:     proto.Clone(ns.GetNamespaceInfo()).(*namespace.NamespaceInfo),
+		Config:            proto.Clone(ns.GetConfig()).(*namespace.NamespaceConfig),
+		ReplicationConfig: proto.Clone(ns.GetReplicationConfig()).(*replication.NamespaceReplicationConfig),
+		IsGlobalNamespace: ns.GetIsGlobalNamespace(),
+	}, nil
+}
+
+// DeleteNamespace removes a namespace with its search attributes and build IDs at once.
+func (s *Server) DeleteNamespace(_ context.Context, req *operatorservice.DeleteNamespaceRequest) (*operatorservice.DeleteNamespaceResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.namespaces[req.GetNamespace()]; !ok {
+		return nil, serviceerror.NewNamespaceNotFound(req.GetNamespace())
+	}
+	delete(s.namespaces, req.GetNamespace())
+	delete(s.searchAttributes, req.GetNamespace())
+	for key := range s.buildIDs {
+		if key.namespace == req.GetNamespace() {
+			delete(s.buildIDs, key)
+		}
+	}
+	return &operatorservice.DeleteNamespaceResponse{DeletedNamespace: req.GetNamespace()}, nil
+}
+
+// ListNamespaces returns all namespaces in a single page.
+func (s *Server) ListNamespaces(context.Context, *workflowservice.ListNamespacesRequest) (*workflowservice.ListNamespacesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resp := &workflowservice.ListNamespacesResponse{}
+	for _, ns := range s.namespaces {
+		resp.Namespaces = append(resp.Namespaces, proto.Clone(ns).(*workflowservice.DescribeNamespaceResponse))
+	}
+	return resp, nil
+}
+
+// GetWorkerBuildIdCompatibility returns the default build ID of a task queue as a single version set.
+func (s *Server) GetWorkerBuildIdCompatibility(_ context.Context, req *workflowservice.GetWorkerBuildIdCompatibilityRequest) (*workflowservice.GetWorkerBuildIdCompatibilityResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resp := &workflowservice.GetWorkerBuildIdCompatibilityResponse{}
+	for _, buildID := range s.buildIDs[taskQueueKey{namespace: req.GetNamespace(), taskQueue: req.GetTaskQueue()}] {
+		resp.MajorVersionSets = append(resp.MajorVersionSets, &taskqueue.CompatibleVersionSet{BuildIds: []string{buildID}})
+	}
+	return resp, nil
+}
+
+// UpdateWorkerBuildIdCompatibility adds and promotes build IDs. Since every build ID has a set of its own,
+// promoting a build ID within its set changes nothing.
+func (s *Server) UpdateWorkerBuildIdCompatibility(_ context.Context, req *workflowservice.UpdateWorkerBuildIdCompatibilityRequest) (*workflowservice.UpdateWorkerBuildIdCompatibilityResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.namespaces[req.GetNamespace()]; !ok {
+		return nil, serviceerror.NewNamespaceNotFound(req.GetNamespace())
+	}
+	key := taskQueueKey{namespace: req.GetNamespace(), taskQueue: req.GetTaskQueue()}
+	switch {
+	case req.GetAddNewBuildIdInNewDefaultSet() != "":
+		if slices.Contains(s.buildIDs[key], req.GetAddNewBuildIdInNewDefaultSet()) {
+			return nil, serviceerror.NewInvalidArgument("build ID already exists: " + req.GetAddNewBuildIdInNewDefaultSet())
+		}
+		s.promoteBuildID(key, req.GetAddNewBuildIdInNewDefaultSet())
+	case req.GetPromoteSetByBuildId() != "":
+		if !slices.Contains(s.buildIDs[key], req.GetPromoteSetByBuildId()) {
+			return nil, serviceerror.NewNotFound("build ID not found: " + req.GetPromoteSetByBuildId())
+		}
+		s.promoteBuildID(key, req.GetPromoteSetByBuildId())
+	case req.GetPromoteBuildIdWithinSet() != "":
+		if !slices.Contains(s.buildIDs[key], req.GetPromoteBuildIdWithinSet()) {
+			return nil, serviceerror.NewNotFound("build ID not found: " + req.GetPromoteBuildIdWithinSet())
+		}
+	default:
+		return nil, serviceerror.NewUnimplemented("operation not supported by the test server")
+	}
+	return &workflowservice.UpdateWorkerBuildIdCompatibilityResponse{}, nil
+}
+
+// ListSearchAttributes returns the custom search attributes of a namespace. System attributes are not listed.
+func (s *Server) ListSearchAttributes(_ context.Context, req *operatorservice.ListSearchAttributesRequest) (*operatorservice.ListSearchAttributesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.namespaces[req.GetNamespace()]; !ok {
+		return nil, serviceerror.NewNamespaceNotFound(req.GetNamespace())
+	}
+	custom := make(map[string]enums.IndexedValueType, len(s.searchAttributes[req.GetNamespace()]))
+	for name, valueType := range s.searchAttributes[req.GetNamespace()] {
+		custom[name] = valueType
+	}
+	return &operatorservice.ListSearchAttributesResponse{CustomAttributes: custom}, nil
+}
+
+// AddSearchAttributes adds custom search attributes to a namespace. Attributes that already exist with the same
+// type are left as they are.
+func (s *Server) AddSearchAttributes(_ context.Context, req *operatorservice.AddSearchAttributesRequest) (*operatorservice.AddSearchAttributesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.namespaces[req.GetNamespace()]; !ok {
+		return nil, serviceerror.NewNamespaceNotFound(req.GetNamespace())
+	}
+	for name, valueType := range req.GetSearchAttributes() {
+		if existing, ok := s.searchAttributes[req.GetNamespace()][name]; ok && existing != valueType {
+			return nil, serviceerror.NewAlreadyExist("Search attribute " + name + " already exists with type " + existing.String())
+		}
+	}
+	if s.searchAttributes[req.GetNamespace()] == nil {
+		s.searchAttributes[req.GetNamespace()] = make(map[string]enums.IndexedValueType)
+	}
+	for name, valueType := range req.GetSearchAttributes() {
+		s.searchAttributes[req.GetNamespace()][name] = valueType
+	}
+	return &operatorservice.AddSearchAttributesResponse{}, nil
+}
+
+// RemoveSearchAttributes removes custom search attributes from a namespace.
+func (s *Server) RemoveSearchAttributes(_ context.Context, req *operatorservice.RemoveSearchAttributesRequest) (*operatorservice.RemoveSearchAttributesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, name := range req.GetSearchAttributes() {
+		if _, ok := s.searchAttributes[req.GetNamespace()][name]; !ok {
+			return nil, serviceerror.NewNotFound("Search attribute " + name + " doesn't exist.")
+		}
+	}
+	for _, name := range req.GetSearchAttributes() {
+		delete(s.searchAttributes[req.GetNamespace()], name)
+	}
+	return &operatorservice.RemoveSearchAttributesResponse{}, nil
+}
@@ -4,8 +4,13 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
-	"terraform-provider-temporal/internal/provider"
+	"github.com/ganievs/terraform-provider-temporal/internal/provider"
+	"github.com/ganievs/terraform-provider-temporal/internal/testserver"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
@@ -16,6 +21,9 @@ import (
 // can be customized.
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs
 
+// Write the example module for terraform test in examples/testing.
+//go:generate go run ./tools/examplegen
+
 // these will be set by the goreleaser configuration
 // to appropriate values for the compiled binary.
 var version string = "dev"
@@ -25,17 +33,44 @@ var version string = "dev"
 
 func main() {
 	var debug bool
+	var fixtureServer string
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.StringVar(&fixtureServer, "fixture-server", "", "instead of the provider, serve an in-memory Temporal frontend on this address, e.g. "+testserver.FixtureAddress+", to test modules with terraform test")
 	flag.Parse()
 
+	if fixtureServer != "" {
+		serveFixture(fixtureServer)
+		return
+	}
+
 	opts := providerserver.ServeOpts{
 		Address: "hashicorp/platacard/terraform-provider-temporal",
 		Debug:   debug,
 	}
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	// Export the spans still buffered when tracing is enabled, before the process exits.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if shutdownErr := provider.ShutdownTracing(ctx); shutdownErr != nil {
+		log.Printf("[WARN] Unable to export traces: %s", shutdownErr)
+	}
 	if err != nil {
 		log.Fatal(err.Error())
 	}
 }
+
+// serveFixture serves the fixture server until the process is interrupted.
+func serveFixture(address string) {
+	server := testserver.NewFixture()
+	if err := server.Start(address); err != nil {
+		log.Fatal(err.Error())
+	}
+	log.Printf("Serving the fixture Temporal frontend on %s", server.Address())
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	<-ctx.Done()
+	server.Stop()
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// BatchSignalResourceModel defines the data schema for a batch signal.
+type BatchSignalResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Namespace              types.String `tfsdk:"namespace"`
+	Query                  types.String `tfsdk:"query"`
+	SignalName             types.String `tfsdk:"signal_name"`
+	Input                  types.List   `tfsdk:"input"`
+	Reason                 types.String `tfsdk:"reason"`
+	Wait                   types.Bool   `tfsdk:"wait"`
+	MatchingCount          types.Int64  `tfsdk:"matching_count"`
+	State                  types.String `tfsdk:"state"`
+	CompleteOperationCount types.Int64  `tfsdk:"complete_operation_count"`
+	FailureOperationCount  types.Int64  `tfsdk:"failure_operation_count"`
+	Cluster                types.String `tfsdk:"cluster"`
+	RPCTimeout             types.String `tfsdk:"rpc_timeout"`
+}
@@ -0,0 +1,13 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CloudNamespaceSearchAttributeResourceModel defines the data schema for a Cloud namespace search attribute.
+type CloudNamespaceSearchAttributeResourceModel struct {
+	Namespace  types.String `tfsdk:"namespace"`
+	Name       types.String `tfsdk:"name"`
+	Type       types.String `tfsdk:"type"`
+	RPCTimeout types.String `tfsdk:"rpc_timeout"`
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ClusterInfoDataSourceModel defines the structure for the data source's read data.
+type ClusterInfoDataSourceModel struct {
+	ClusterName       types.String           `tfsdk:"cluster_name"`
+	ClusterId         types.String           `tfsdk:"cluster_id"`
+	ServerVersion     types.String           `tfsdk:"server_version"`
+	HistoryShardCount types.Int64            `tfsdk:"history_shard_count"`
+	PersistenceStore  types.String           `tfsdk:"persistence_store"`
+	VisibilityStore   types.String           `tfsdk:"visibility_store"`
+	Clusters          []ClusterMetadataModel `tfsdk:"clusters"`
+}
+
+// ClusterMetadataModel describes a cluster known to the connected cluster, including itself.
+type ClusterMetadataModel struct {
+	ClusterName            types.String `tfsdk:"cluster_name"`
+	ClusterId              types.String `tfsdk:"cluster_id"`
+	Address                types.String `tfsdk:"address"`
+	HttpAddress            types.String `tfsdk:"http_address"`
+	InitialFailoverVersion types.Int64  `tfsdk:"initial_failover_version"`
+	HistoryShardCount      types.Int64  `tfsdk:"history_shard_count"`
+	IsConnectionEnabled    types.Bool   `tfsdk:"is_connection_enabled"`
+}
@@ -0,0 +1,6 @@
+// Package models holds the state models of the provider's resources and data sources, with the tfsdk tags that
+// map them to their schemas. Tools that embed the provider, e.g. CDKTF constructs or plan checks, can decode plan
+// and state values into them with the framework's Get and GetAttribute methods.
+//
+// The schemas themselves stay with the resources in internal/provider; a field added to a schema is added here too.
+package models
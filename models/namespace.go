@@ -0,0 +1,66 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NamespaceDataSourceModel defines the structure for the data source's configuration and read data.
+type NamespaceDataSourceModel struct {
+	Name                     types.String                       `tfsdk:"name"`
+	Id                       types.String                       `tfsdk:"id"`
+	Description              types.String                       `tfsdk:"description"`
+	OwnerEmail               types.String                       `tfsdk:"owner_email"`
+	Retention                types.Int64                        `tfsdk:"retention"`
+	ActiveClusterName        types.String                       `tfsdk:"active_cluster_name"`
+	HistoryArchivalState     types.String                       `tfsdk:"history_archival_state"`
+	HistoryArchivalUri       types.String                       `tfsdk:"history_archival_uri"`
+	VisibilityArchivalState  types.String                       `tfsdk:"visibility_archival_state"`
+	VisibilityArchivalUri    types.String                       `tfsdk:"visibility_archival_uri"`
+	IsGlobalNamespace        types.Bool                         `tfsdk:"is_global_namespace"`
+	State                    types.String                       `tfsdk:"state"`
+	Data                     types.Map                          `tfsdk:"data"`
+	FailoverVersion          types.Int64                        `tfsdk:"failover_version"`
+	FailoverVersionIncrement types.Int64                        `tfsdk:"failover_version_increment"`
+	Clusters                 []NamespaceReplicationClusterModel `tfsdk:"clusters"`
+	IncludeStats             types.Bool                         `tfsdk:"include_stats"`
+	Stats                    *NamespaceStatsModel               `tfsdk:"stats"`
+	RawJSON                  types.String                       `tfsdk:"raw_json"`
+}
+
+// NamespaceStatsModel holds the counts read when include_stats is set.
+type NamespaceStatsModel struct {
+	Schedules              types.Int64 `tfsdk:"schedules"`
+	CustomSearchAttributes types.Int64 `tfsdk:"custom_search_attributes"`
+	RunningWorkflows       types.Int64 `tfsdk:"running_workflows"`
+}
+
+// NamespaceReplicationClusterModel describes a cluster the namespace is replicated to.
+type NamespaceReplicationClusterModel struct {
+	ClusterName            types.String `tfsdk:"cluster_name"`
+	InitialFailoverVersion types.Int64  `tfsdk:"initial_failover_version"`
+	NextFailoverVersion    types.Int64  `tfsdk:"next_failover_version"`
+}
+
+// NamespaceResourceModel defines the data schema for a Temporal namespace resource.
+type NamespaceResourceModel struct {
+	Name                    types.String `tfsdk:"name"`
+	Id                      types.String `tfsdk:"id"`
+	Description             types.String `tfsdk:"description"`
+	OwnerEmail              types.String `tfsdk:"owner_email"`
+	Retention               types.Int64  `tfsdk:"retention"`
+	ActiveClusterName       types.String `tfsdk:"active_cluster_name"`
+	HistoryArchivalState    types.String `tfsdk:"history_archival_state"`
+	HistoryArchivalUri      types.String `tfsdk:"history_archival_uri"`
+	VisibilityArchivalState types.String `tfsdk:"visibility_archival_state"`
+	VisibilityArchivalUri   types.String `tfsdk:"visibility_archival_uri"`
+	IsGlobalNamespace       types.Bool   `tfsdk:"is_global_namespace"`
+	State                   types.String `tfsdk:"state"`
+	Data                    types.Map    `tfsdk:"data"`
+	SensitiveData           types.Map    `tfsdk:"sensitive_data"`
+	HealthCheck             types.Object `tfsdk:"health_check"`
+	OnCreateWorkflow        types.Object `tfsdk:"on_create_workflow"`
+	PreDestroyWorkflow      types.Object `tfsdk:"pre_destroy_workflow"`
+	Cluster                 types.String `tfsdk:"cluster"`
+	DeleteSnapshotPath      types.String `tfsdk:"delete_snapshot_path"`
+	RPCTimeout              types.String `tfsdk:"rpc_timeout"`
+}
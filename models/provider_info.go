@@ -0,0 +1,18 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ProviderInfoDataSourceModel defines the structure for the data source's read data.
+type ProviderInfoDataSourceModel struct {
+	Address                   types.String `tfsdk:"address"`
+	AuthMode                  types.String `tfsdk:"auth_mode"`
+	TLS                       types.Bool   `tfsdk:"tls"`
+	ClientCertificate         types.Bool   `tfsdk:"client_certificate"`
+	TLSVersion                types.String `tfsdk:"tls_version"`
+	ServerCertificateSubject  types.String `tfsdk:"server_certificate_subject"`
+	ServerCertificateIssuer   types.String `tfsdk:"server_certificate_issuer"`
+	ServerCertificateNotAfter types.String `tfsdk:"server_certificate_not_after"`
+	ServerVersion             types.String `tfsdk:"server_version"`
+}
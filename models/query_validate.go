@@ -0,0 +1,13 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// QueryValidateDataSourceModel defines the structure for the data source's configuration and read data.
+type QueryValidateDataSourceModel struct {
+	Namespace types.String `tfsdk:"namespace"`
+	Query     types.String `tfsdk:"query"`
+	Valid     types.Bool   `tfsdk:"valid"`
+	Error     types.String `tfsdk:"error"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SearchAttributeDataSourceModel defines the structure for the data source's configuration and read data.
+type SearchAttributeDataSourceModel struct {
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	Namespace types.String `tfsdk:"namespace"`
+}
+
+// SearchAttributeResourceModel defines the data schema for a Temporal search attribute resource.
+type SearchAttributeResourceModel struct {
+	Name       types.String `tfsdk:"name"`
+	Type       types.String `tfsdk:"type"`
+	Namespace  types.String `tfsdk:"namespace"`
+	Cluster    types.String `tfsdk:"cluster"`
+	RPCTimeout types.String `tfsdk:"rpc_timeout"`
+}
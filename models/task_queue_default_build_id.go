@@ -0,0 +1,15 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TaskQueueDefaultBuildIDResourceModel defines the data schema for the default build ID of a task queue.
+type TaskQueueDefaultBuildIDResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Namespace  types.String `tfsdk:"namespace"`
+	TaskQueue  types.String `tfsdk:"task_queue"`
+	BuildID    types.String `tfsdk:"build_id"`
+	Cluster    types.String `tfsdk:"cluster"`
+	RPCTimeout types.String `tfsdk:"rpc_timeout"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// WorkflowHistoryCountDataSourceModel defines the structure for the data source's configuration and read data.
+type WorkflowHistoryCountDataSourceModel struct {
+	Namespace   types.String              `tfsdk:"namespace"`
+	BucketSize  types.String              `tfsdk:"bucket_size"`
+	BucketCount types.Int64               `tfsdk:"bucket_count"`
+	Total       types.Int64               `tfsdk:"total"`
+	Buckets     []HistoryCountBucketModel `tfsdk:"buckets"`
+}
+
+// HistoryCountBucketModel is the number of workflow executions closed within one time range.
+type HistoryCountBucketModel struct {
+	StartTime types.String `tfsdk:"start_time"`
+	EndTime   types.String `tfsdk:"end_time"`
+	Count     types.Int64  `tfsdk:"count"`
+}
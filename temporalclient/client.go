@@ -0,0 +1,24 @@
+// Package temporalclient holds the typed Temporal service clients and the wait helpers the provider manages
+// objects with, for tools that drive a cluster the way the provider does, e.g. CDKTF constructs or migration
+// scripts. It depends on the Temporal API only, not on Terraform; the resource models are in package models.
+package temporalclient
+
+import (
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+)
+
+// Client holds the typed clients of the services of a Temporal frontend.
+type Client struct {
+	WorkflowService workflowservice.WorkflowServiceClient
+	OperatorService operatorservice.OperatorServiceClient
+}
+
+// New wraps a connection to a Temporal frontend.
+func New(conn grpc.ClientConnInterface) *Client {
+	return &Client{
+		WorkflowService: workflowservice.NewWorkflowServiceClient(conn),
+		OperatorService: operatorservice.NewOperatorServiceClient(conn),
+	}
+}
@@ -0,0 +1,131 @@
+package temporalclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.temporal.io/api/cloud/cloudservice/v1"
+	cloudoperation "go.temporal.io/api/cloud/operation/v1"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/history/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// WaitForReady connects and waits until the connection is ready or the timeout expires.
+func WaitForReady(ctx context.Context, conn *grpc.ClientConn, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	conn.Connect()
+	for {
+		state := conn.GetState()
+		if state == connectivity.Ready {
+			return nil
+		}
+		if !conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("no connection after %s, last state %s", timeout, state)
+		}
+	}
+}
+
+// AwaitSearchAttribute checks every interval whether a search attribute added with AddSearchAttributes is
+// listed for the namespace, and returns once it is.
+func AwaitSearchAttribute(ctx context.Context, client operatorservice.OperatorServiceClient, namespace, name string, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			attributes, err := client.ListSearchAttributes(ctx, &operatorservice.ListSearchAttributesRequest{
+				Namespace: namespace,
+			})
+			if err != nil {
+				return err
+			}
+
+			if _, ok := attributes.CustomAttributes[name]; ok {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// AwaitWorkflow long-polls the history of a workflow run for its close event, and returns an error unless the
+// run completed.
+func AwaitWorkflow(ctx context.Context, client workflowservice.WorkflowServiceClient, namespace, workflowID, runID string) error {
+	var pageToken []byte
+	for {
+		page, err := client.GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+			Namespace:              namespace,
+			Execution:              &common.WorkflowExecution{WorkflowId: workflowID, RunId: runID},
+			WaitNewEvent:           true,
+			HistoryEventFilterType: enums.HISTORY_EVENT_FILTER_TYPE_CLOSE_EVENT,
+			NextPageToken:          pageToken,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("workflow %s did not complete in time: %w", workflowID, ctx.Err())
+			}
+			return err
+		}
+
+		if events := page.GetHistory().GetEvents(); len(events) > 0 {
+			return closeEventError(workflowID, events[len(events)-1])
+		}
+		// The long poll timed out without the workflow closing.
+		pageToken = page.GetNextPageToken()
+	}
+}
+
+// closeEventError returns nil for a completed workflow, and describes how it closed otherwise.
+func closeEventError(workflowID string, event *history.HistoryEvent) error {
+	switch event.GetEventType() {
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
+		return nil
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+		return fmt.Errorf("workflow %s failed: %s", workflowID, event.GetWorkflowExecutionFailedEventAttributes().GetFailure().GetMessage())
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+		return fmt.Errorf("workflow %s continued as new, which is not followed; use a workflow that completes", workflowID)
+	default:
+		return fmt.Errorf("workflow %s did not complete: %s", workflowID, event.GetEventType())
+	}
+}
+
+// AwaitCloudOperation waits until an asynchronous operation of the Cloud Ops API is fulfilled. It checks the
+// operation as often as the API suggests, and every interval when it does not.
+func AwaitCloudOperation(ctx context.Context, client cloudservice.CloudServiceClient, operation *cloudoperation.AsyncOperation, interval time.Duration) error {
+	for {
+		switch operation.GetState() {
+		case cloudoperation.AsyncOperation_STATE_FULFILLED:
+			return nil
+		case cloudoperation.AsyncOperation_STATE_FAILED:
+			return fmt.Errorf("operation %s failed: %s", operation.GetId(), operation.GetFailureReason())
+		case cloudoperation.AsyncOperation_STATE_CANCELLED:
+			return fmt.Errorf("operation %s was cancelled", operation.GetId())
+		}
+
+		wait := interval
+		if check := operation.GetCheckDuration(); check != nil && check.AsDuration() > 0 {
+			wait = check.AsDuration()
+		}
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		resp, err := client.GetAsyncOperation(ctx, &cloudservice.GetAsyncOperationRequest{AsyncOperationId: operation.GetId()})
+		if err != nil {
+			return err
+		}
+		operation = resp.GetAsyncOperation()
+	}
+}
@@ -0,0 +1,158 @@
+package temporalclient
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go.temporal.io/api/cloud/cloudservice/v1"
+	cloudoperation "go.temporal.io/api/cloud/operation/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/failure/v1"
+	"go.temporal.io/api/history/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+)
+
+// fakeHistoryClient returns one history page per call, the last one again once they run out.
+type fakeHistoryClient struct {
+	workflowservice.WorkflowServiceClient
+	pages []*workflowservice.GetWorkflowExecutionHistoryResponse
+	calls int
+}
+
+func (c *fakeHistoryClient) GetWorkflowExecutionHistory(ctx context.Context, _ *workflowservice.GetWorkflowExecutionHistoryRequest, _ ...grpc.CallOption) (*workflowservice.GetWorkflowExecutionHistoryResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	page := c.pages[min(c.calls, len(c.pages)-1)]
+	c.calls++
+	return page, nil
+}
+
+// historyPage returns a page holding the given events.
+func historyPage(events ...*history.HistoryEvent) *workflowservice.GetWorkflowExecutionHistoryResponse {
+	return &workflowservice.GetWorkflowExecutionHistoryResponse{History: &history.History{Events: events}}
+}
+
+func TestAwaitWorkflow(t *testing.T) {
+	failed := &history.HistoryEvent{
+		EventType: enums.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED,
+		Attributes: &history.HistoryEvent_WorkflowExecutionFailedEventAttributes{
+			WorkflowExecutionFailedEventAttributes: &history.WorkflowExecutionFailedEventAttributes{Failure: &failure.Failure{Message: "boom"}},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		pages     []*workflowservice.GetWorkflowExecutionHistoryResponse
+		wantErr   string
+		wantCalls int
+	}{
+		{name: "completed", pages: []*workflowservice.GetWorkflowExecutionHistoryResponse{historyPage(&history.HistoryEvent{EventType: enums.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED})}, wantCalls: 1},
+		{name: "completed after a long poll timed out", pages: []*workflowservice.GetWorkflowExecutionHistoryResponse{historyPage(), historyPage(&history.HistoryEvent{EventType: enums.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED})}, wantCalls: 2},
+		{name: "failed", pages: []*workflowservice.GetWorkflowExecutionHistoryResponse{historyPage(failed)}, wantErr: "failed: boom", wantCalls: 1},
+		{name: "continued as new", pages: []*workflowservice.GetWorkflowExecutionHistoryResponse{historyPage(&history.HistoryEvent{EventType: enums.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW})}, wantErr: "continued as new", wantCalls: 1},
+		{name: "timed out", pages: []*workflowservice.GetWorkflowExecutionHistoryResponse{historyPage(&history.HistoryEvent{EventType: enums.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT})}, wantErr: "did not complete", wantCalls: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeHistoryClient{pages: tt.pages}
+			err := AwaitWorkflow(context.Background(), client, "orders", "setup", "run")
+			if tt.wantErr == "" && err != nil || tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("AwaitWorkflow() error = %v, want %q", err, tt.wantErr)
+			}
+			if client.calls != tt.wantCalls {
+				t.Errorf("AwaitWorkflow() polled %d times, want %d", client.calls, tt.wantCalls)
+			}
+		})
+	}
+
+	t.Run("deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		<-ctx.Done()
+		err := AwaitWorkflow(ctx, &fakeHistoryClient{pages: []*workflowservice.GetWorkflowExecutionHistoryResponse{historyPage()}}, "orders", "setup", "run")
+		if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "did not complete in time") {
+			t.Errorf("AwaitWorkflow() error = %v, want the deadline", err)
+		}
+	})
+}
+
+// fakeSearchAttributeClient lists the search attribute once it has been asked listedAfter times.
+type fakeSearchAttributeClient struct {
+	operatorservice.OperatorServiceClient
+	listedAfter int
+	calls       int
+}
+
+func (c *fakeSearchAttributeClient) ListSearchAttributes(_ context.Context, req *operatorservice.ListSearchAttributesRequest, _ ...grpc.CallOption) (*operatorservice.ListSearchAttributesResponse, error) {
+	c.calls++
+	resp := &operatorservice.ListSearchAttributesResponse{CustomAttributes: map[string]enums.IndexedValueType{}}
+	if req.GetNamespace() == "orders" && c.calls >= c.listedAfter {
+		resp.CustomAttributes["CustomerId"] = enums.INDEXED_VALUE_TYPE_KEYWORD
+	}
+	return resp, nil
+}
+
+func TestAwaitSearchAttribute(t *testing.T) {
+	client := &fakeSearchAttributeClient{listedAfter: 3}
+	if err := AwaitSearchAttribute(context.Background(), client, "orders", "CustomerId", time.Millisecond); err != nil {
+		t.Fatalf("AwaitSearchAttribute() error = %v", err)
+	}
+	if client.calls != 3 {
+		t.Errorf("AwaitSearchAttribute() listed %d times, want 3", client.calls)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := AwaitSearchAttribute(ctx, client, "payments", "CustomerId", time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("AwaitSearchAttribute() error = %v, want the deadline", err)
+	}
+}
+
+// fakeCloudClient returns the operations in turn.
+type fakeCloudClient struct {
+	cloudservice.CloudServiceClient
+	operations []*cloudoperation.AsyncOperation
+	calls      int
+}
+
+func (c *fakeCloudClient) GetAsyncOperation(_ context.Context, _ *cloudservice.GetAsyncOperationRequest, _ ...grpc.CallOption) (*cloudservice.GetAsyncOperationResponse, error) {
+	operation := c.operations[c.calls]
+	c.calls++
+	return &cloudservice.GetAsyncOperationResponse{AsyncOperation: operation}, nil
+}
+
+func TestAwaitCloudOperation(t *testing.T) {
+	pending := &cloudoperation.AsyncOperation{Id: "op", State: cloudoperation.AsyncOperation_STATE_IN_PROGRESS}
+
+	tests := []struct {
+		name       string
+		operations []*cloudoperation.AsyncOperation
+		wantErr    string
+	}{
+		{name: "fulfilled", operations: []*cloudoperation.AsyncOperation{pending, {Id: "op", State: cloudoperation.AsyncOperation_STATE_FULFILLED}}},
+		{name: "failed", operations: []*cloudoperation.AsyncOperation{{Id: "op", State: cloudoperation.AsyncOperation_STATE_FAILED, FailureReason: "quota"}}, wantErr: "operation op failed: quota"},
+		{name: "cancelled", operations: []*cloudoperation.AsyncOperation{{Id: "op", State: cloudoperation.AsyncOperation_STATE_CANCELLED}}, wantErr: "was cancelled"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeCloudClient{operations: tt.operations}
+			err := AwaitCloudOperation(context.Background(), client, pending, time.Millisecond)
+			if tt.wantErr == "" && err != nil || tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("AwaitCloudOperation() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("already fulfilled", func(t *testing.T) {
+		client := &fakeCloudClient{}
+		if err := AwaitCloudOperation(context.Background(), client, &cloudoperation.AsyncOperation{State: cloudoperation.AsyncOperation_STATE_FULFILLED}, time.Hour); err != nil || client.calls != 0 {
+			t.Errorf("AwaitCloudOperation() = %v after %d calls, want no call", err, client.calls)
+		}
+	})
+}
@@ -0,0 +1,185 @@
+// Command examplegen writes the example module in examples/testing and its terraform test files. The expected
+// values come from the seed data of the fixture server, so the examples keep passing against it when the seed
+// data changes. Run it with go generate from the repository root.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/template"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+
+	"github.com/ganievs/terraform-provider-temporal/internal/testserver"
+)
+
+const header = "# Code generated by tools/examplegen; DO NOT EDIT.\n\n"
+
+// searchAttribute is a seeded search attribute, with the type name the provider uses.
+type searchAttribute struct {
+	Name string
+	Type string
+}
+
+// fixture is the data the templates are rendered with.
+type fixture struct {
+	Address          string
+	Namespace        string
+	RetentionDays    int
+	SearchAttributes []searchAttribute
+}
+
+var files = map[string]string{
+	"main.tf": `terraform {
+  required_providers {
+    temporal = {
+      source = "hashicorp/platacard/temporal"
+    }
+  }
+}
+
+variable "name" {
+  description = "Name of the namespace the module manages."
+  type        = string
+}
+
+variable "retention" {
+  description = "Workflow execution retention of the namespace, in days."
+  type        = number
+  default     = 7
+}
+
+resource "temporal_namespace" "this" {
+  name        = var.name
+  description = "Managed by the example module"
+  owner_email = "platform@example.com"
+  retention   = var.retention
+}
+
+resource "temporal_search_attribute" "customer_id" {
+  namespace = temporal_namespace.this.name
+  name      = "CustomerId"
+  type      = "Keyword"
+}
+
+data "temporal_namespace" "default" {
+  name = "{{.Namespace}}"
+}
+
+output "namespace_id" {
+  value = temporal_namespace.this.id
+}
+`,
+	"tests/namespace.tftest.hcl": `# Start the fixture server before running terraform test:
+#
+#   terraform-provider-temporal -fixture-server {{.Address}}
+provider "temporal" {
+  address        = "{{.Address}}"
+  allow_insecure = true
+}
+
+variables {
+  name = "orders"
+}
+
+run "creates_the_namespace" {
+  assert {
+    condition     = temporal_namespace.this.state == "Registered"
+    error_message = "The namespace is not registered."
+  }
+
+  assert {
+    condition     = temporal_namespace.this.retention == 7
+    error_message = "The default retention is not applied."
+  }
+
+  assert {
+    condition     = output.namespace_id != ""
+    error_message = "The namespace has no ID."
+  }
+}
+
+run "reads_the_seeded_namespace" {
+  command = plan
+
+  assert {
+    condition     = data.temporal_namespace.default.retention == {{.RetentionDays}}
+    error_message = "The {{.Namespace}} namespace does not have the seeded retention."
+  }
+}
+`,
+	"tests/search_attributes.tftest.hcl": `provider "temporal" {
+  address        = "{{.Address}}"
+  allow_insecure = true
+}
+{{range .SearchAttributes}}
+run "reads_{{.Name}}" {
+  command = plan
+
+  module {
+    source = "./tests/search_attribute"
+  }
+
+  variables {
+    name = "{{.Name}}"
+  }
+
+  assert {
+    condition     = data.temporal_search_attribute.this.type == "{{.Type}}"
+    error_message = "{{.Name}} is not seeded as {{.Type}}."
+  }
+}
+{{end}}`,
+	"tests/search_attribute/main.tf": `terraform {
+  required_providers {
+    temporal = {
+      source = "hashicorp/platacard/temporal"
+    }
+  }
+}
+
+variable "name" {
+  type = string
+}
+
+data "temporal_search_attribute" "this" {
+  namespace = "{{.Namespace}}"
+  name      = var.name
+}
+`,
+}
+
+func main() {
+	out := flag.String("out", "examples/testing", "directory to write the example module to")
+	flag.Parse()
+
+	data := fixture{
+		Address:       testserver.FixtureAddress,
+		Namespace:     testserver.FixtureNamespace,
+		RetentionDays: testserver.FixtureRetentionDays,
+	}
+	for name, valueType := range testserver.FixtureSearchAttributes {
+		data.SearchAttributes = append(data.SearchAttributes, searchAttribute{Name: name, Type: valueType.String()})
+	}
+	sort.Slice(data.SearchAttributes, func(i, j int) bool { return data.SearchAttributes[i].Name < data.SearchAttributes[j].Name })
+
+	for name, text := range files {
+		var b bytes.Buffer
+		b.WriteString(header)
+		if err := template.Must(template.New(name).Parse(text)).Execute(&b, data); err != nil {
+			log.Fatalf("rendering %s: %s", name, err)
+		}
+
+		path := filepath.Join(*out, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(path, hclwrite.Format(b.Bytes()), 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}